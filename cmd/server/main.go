@@ -6,15 +6,21 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 
 	gravityapi "github.com/c12s/gravity/pkg/api"
 	magnetarapi "github.com/c12s/magnetar/pkg/api"
+	"github.com/c12s/magnetar/pkg/messaging/nats"
+	"github.com/c12s/meridian/internal/domain"
 	"github.com/c12s/meridian/internal/handlers"
 	"github.com/c12s/meridian/internal/store"
+	"github.com/c12s/meridian/internal/workers"
 	"github.com/c12s/meridian/pkg/api"
 	oortapi "github.com/c12s/oort/pkg/api"
 	pulsar_api "github.com/c12s/pulsar/model/protobuf"
+	natsgo "github.com/nats-io/nats.go"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -22,16 +28,57 @@ import (
 )
 
 func main() {
-	neo4jAddress := os.Getenv("NEO4J_ADDRESS")
-	driver, err := neo4j.NewDriver(fmt.Sprintf("bolt://%s", neo4jAddress), neo4j.NoAuth())
+	var namespaces domain.NamespaceStore
+	var apps domain.AppStore
+	var reservations domain.ReservationStore
+	var quotaRequests domain.QuotaRequestStore
+	var schedules domain.ScheduledQuotaChangeStore
+	var quotaHistory domain.QuotaHistoryStore
+	var prices domain.ResourcePriceStore
+	var quotas domain.ResourceQuotaStore
+	var resourceTypes domain.ResourceTypeStore
+	var txManager domain.TxManager
+	switch os.Getenv("STORE_BACKEND") {
+	case "memory":
+		db := store.NewMemoryDb()
+		txManager = store.NewMemoryTxManager(db)
+		resourceTypes = store.NewResourceTypeMemoryStore(db)
+		quotas = store.NewResourceQuotaMemoryStore(db)
+		apps = store.NewAppMemoryStore(db)
+		reservations = store.NewReservationMemoryStore(db)
+		quotaRequests = store.NewQuotaRequestMemoryStore(db)
+		schedules = store.NewScheduledQuotaChangeMemoryStore(db)
+		quotaHistory = store.NewQuotaHistoryMemoryStore(db)
+		prices = store.NewResourcePriceMemoryStore(db)
+		namespaces = store.NewNamespaceMemoryStore(db)
+	default:
+		neo4jAddress := os.Getenv("NEO4J_ADDRESS")
+		driver, err := neo4j.NewDriver(fmt.Sprintf("bolt://%s", neo4jAddress), neo4j.NoAuth())
+		if err != nil {
+			log.Fatal(err)
+		}
+		dbName := os.Getenv("NEO4J_DB_NAME")
+		err = store.MigrateNeo4j(driver, dbName)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		txManager = store.NewNeo4jTxManager(driver, dbName)
+		resourceTypes = store.NewResourceTypeNeo4jStore(driver, dbName)
+		quotas = store.NewResourceQuotaNeo4jStore(driver, dbName)
+		apps = store.NewAppNeo4jStore(driver, dbName, quotas)
+		reservations = store.NewReservationNeo4jStore(driver, dbName, quotas)
+		quotaRequests = store.NewQuotaRequestNeo4jStore(driver, dbName)
+		schedules = store.NewScheduledQuotaChangeNeo4jStore(driver, dbName)
+		quotaHistory = store.NewQuotaHistoryNeo4jStore(driver, dbName)
+		prices = store.NewResourcePriceNeo4jStore(driver, dbName)
+		namespaces = store.NewNamespaceNeo4jStore(driver, dbName, quotas, apps)
+	}
+	resourceTypeRegistry := domain.NewResourceTypeRegistry(resourceTypes)
+	err := resourceTypeRegistry.Load()
 	if err != nil {
 		log.Fatal(err)
 	}
-	dbName := os.Getenv("NEO4J_DB_NAME")
-
-	quotas := store.NewResourceQuotaNeo4jStore(driver, dbName)
-	apps := store.NewAppNeo4jStore(driver, dbName, quotas)
-	namespaces := store.NewNamespaceNeo4jStore(driver, dbName, quotas, apps)
 	conn, err := grpc.NewClient(os.Getenv("PULSAR_ADDRESS"), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		log.Fatal(err)
@@ -54,7 +101,56 @@ func main() {
 	}
 	defer conn.Close()
 	magnetar := magnetarapi.NewMagnetarClient(connMagnetar)
-	meridian := handlers.NewMeridianGrpcHandler(namespaces, apps, pulsar, quotas, administrator, gravity, magnetar)
+	connOort, err := grpc.NewClient(os.Getenv("OORT_ADDRESS"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer connOort.Close()
+	evaluator := oortapi.NewOortEvaluatorClient(connOort)
+	// app removals and utilization alerts are published to the nats server that carries the oort requests, the oort client
+	// opens its own connection from an address and cannot share this one
+	connNats, err := natsgo.Connect(fmt.Sprintf("nats://%s", os.Getenv("NATS_ADDRESS")))
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer connNats.Close()
+	publisher, err := nats.NewPublisher(connNats)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	observers := workers.Observers{
+		Alerter:  workers.NewUtilizationAlerter(publisher, namespaces),
+		Recorder: workers.NewQuotaHistoryRecorder(quotaHistory, namespaces, apps),
+	}
+	auth := handlers.Auth{
+		TokenSecret: []byte(os.Getenv("TOKEN_SECRET")),
+	}
+	// until clients send tokens, quotas can be set directly without the permission to manage them
+	if open := os.Getenv("OPEN_QUOTA_CHANGES"); open != "" {
+		auth.OpenQuotaChanges, err = strconv.ParseBool(open)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	meridian := handlers.NewMeridianGrpcHandler(handlers.Stores{
+		Namespaces:    namespaces,
+		Apps:          apps,
+		Resources:     quotas,
+		ResourceTypes: resourceTypes,
+		Reservations:  reservations,
+		QuotaRequests: quotaRequests,
+		Schedules:     schedules,
+		QuotaHistory:  quotaHistory,
+		Prices:        prices,
+		TxManager:     txManager,
+	}, handlers.Clients{
+		Pulsar:        pulsar,
+		Administrator: administrator,
+		Gravity:       gravity,
+		Magnetar:      magnetar,
+		Publisher:     publisher,
+		Evaluator:     evaluator,
+	}, observers, resourceTypeRegistry, auth)
 
 	s := grpc.NewServer()
 	api.RegisterMeridianServer(s, meridian)
@@ -65,6 +161,41 @@ func main() {
 		log.Fatal(err)
 	}
 
+	// other replicas can put and remove resource types too
+	refreshInterval := 10 * time.Second
+	if interval := os.Getenv("RESOURCE_TYPE_REFRESH_INTERVAL"); interval != "" {
+		refreshInterval, err = time.ParseDuration(interval)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	sweepInterval := time.Minute
+	if interval := os.Getenv("RESERVATION_SWEEP_INTERVAL"); interval != "" {
+		sweepInterval, err = time.ParseDuration(interval)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	scheduleInterval := 30 * time.Second
+	if interval := os.Getenv("QUOTA_SCHEDULER_INTERVAL"); interval != "" {
+		scheduleInterval, err = time.ParseDuration(interval)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	stopWorkers := make(chan struct{})
+	go resourceTypeRegistry.Watch(refreshInterval, stopWorkers)
+	workerStores := workers.Stores{
+		Namespaces:   namespaces,
+		Apps:         apps,
+		Resources:    quotas,
+		Reservations: reservations,
+		Schedules:    schedules,
+		TxManager:    txManager,
+	}
+	go workers.NewReservationSweeper(workerStores, observers, sweepInterval).Run(stopWorkers)
+	go workers.NewQuotaScheduler(workerStores, observers, scheduleInterval).Run(stopWorkers)
+
 	shutdown := make(chan os.Signal, 1)
 	signal.Notify(shutdown, syscall.SIGTERM, syscall.SIGINT)
 
@@ -77,5 +208,6 @@ func main() {
 
 	<-shutdown
 
+	close(stopWorkers)
 	s.GracefulStop()
 }
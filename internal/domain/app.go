@@ -16,6 +16,7 @@ type App struct {
 	name           string
 	resourceQuotas ResourceQuotas
 	profileVersion string
+	nodes          []string
 }
 
 func NewApp(namespace Namespace, name, profileVersion string) App {
@@ -49,14 +50,35 @@ func (a App) GetResourceQuotas() ResourceQuotas {
 	return quotas
 }
 
-func (a *App) AddResourceQuota(resource string, quota float64) error {
-	if !slices.Contains(SupportedResourceQuotas, resource) {
-		return fmt.Errorf("quotas for a resource with name %s are not supported", resource)
+// AddResourceQuota does not check the resource type, quotas sent by clients
+// are validated by the ResourceTypeRegistry of the org first.
+func (a *App) AddResourceQuota(resource string, quota int64) error {
+	if quota < 0 {
+		return fmt.Errorf("quota for the resource %s must not be negative", resource)
 	}
 	a.resourceQuotas[resource] = quota
 	return nil
 }
 
+// CheckFitsIn returns an error if the app quotas exceed the resources available in its namespace.
+func (a App) CheckFitsIn(available ResourceQuotas) error {
+	for resource, quota := range a.resourceQuotas {
+		if available[resource] < quota {
+			return fmt.Errorf("requested %s quota for the resource %s, but only %s available in namespace", FormatMilliUnits(quota), resource, FormatMilliUnits(available[resource]))
+		}
+	}
+	return nil
+}
+
+// GetNodes returns the ids of the nodes the app config was disseminated to.
+func (a App) GetNodes() []string {
+	return slices.Clone(a.nodes)
+}
+
+func (a *App) SetNodes(nodes []string) {
+	a.nodes = slices.Clone(nodes)
+}
+
 func (a App) GetSeccompProfile() SeccompProfile {
 	return SeccompProfile{
 		Namespace:    a.namespace.GetId(),
@@ -72,15 +94,30 @@ func (a *App) MarshalJSON() ([]byte, error) {
 		Name           string         `json:"name"`
 		SeccompProfile SeccompProfile `json:"seccomp_profile"`
 		ResourceQuotas ResourceQuotas `json:"resource_quotas"`
+		Nodes          []string       `json:"nodes"`
 	}{
 		Name:           a.name,
 		SeccompProfile: a.GetSeccompProfile(),
 		ResourceQuotas: a.resourceQuotas,
+		Nodes:          a.nodes,
 	})
 }
 
+type AppQuery struct {
+	OrgId string
+	// NamespaceId limits the query to the apps of a single namespace, all apps of the org are listed if it is empty.
+	NamespaceId string
+	// After is the id of the last app of the previous page,
+	// apps are listed in the order of their ids.
+	After string
+	Limit int
+}
+
 type AppStore interface {
-	Add(app App) error
-	FindChildren(namespace Namespace) ([]App, error)
-	Remove(id string) error
+	Add(tx Tx, app App) error
+	Get(tx Tx, id string) (App, error)
+	FindChildren(tx Tx, namespace Namespace) ([]App, error)
+	List(tx Tx, query AppQuery) ([]App, error)
+	SetNodes(tx Tx, id string, nodes []string) error
+	Remove(tx Tx, id string) error
 }
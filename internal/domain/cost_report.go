@@ -0,0 +1,292 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+// AllocatedMilliUnitHours integrates the allocated quotas in the samples of an entity over the range,
+// which gives the milli unit hours of each resource. The latest sample before from has to be included,
+// so that the quotas at the start of the range are known.
+func AllocatedMilliUnitHours(samples []QuotaSample, from, to time.Time) map[string]*big.Rat {
+	hours := make(map[string]*big.Rat)
+	for _, series := range GroupByResource(samples) {
+		slices.SortStableFunc(series, func(a, b QuotaSample) int {
+			return a.Timestamp.Compare(b.Timestamp)
+		})
+		total := new(big.Rat)
+		for i, sample := range series {
+			start := sample.Timestamp
+			if start.Before(from) {
+				start = from
+			}
+			end := to
+			if i+1 < len(series) && series[i+1].Timestamp.Before(to) {
+				end = series[i+1].Timestamp
+			}
+			if !end.After(start) || sample.Allocated == 0 {
+				continue
+			}
+			product := new(big.Int).Mul(big.NewInt(sample.Allocated), big.NewInt(end.Sub(start).Milliseconds()))
+			total.Add(total, new(big.Rat).SetFrac(product, big.NewInt(time.Hour.Milliseconds())))
+		}
+		hours[series[0].Resource] = total
+	}
+	return hours
+}
+
+// ConstantMilliUnitHours returns the milli unit hours of quotas that did not change over the range.
+func ConstantMilliUnitHours(quotas ResourceQuotas, from, to time.Time) map[string]*big.Rat {
+	samples := make([]QuotaSample, 0, len(quotas))
+	for resource, quota := range quotas {
+		samples = append(samples, QuotaSample{Resource: resource, Allocated: quota, Timestamp: from})
+	}
+	return AllocatedMilliUnitHours(samples, from, to)
+}
+
+type NamespaceCost struct {
+	Name string
+	// empty for the namespace the report is for
+	Parent string
+	Labels map[string]string
+	// milli unit hours of the resources allocated to the namespace and not handed out to its child namespaces,
+	// which includes the quotas of its apps
+	MilliUnitHours map[string]*big.Rat
+	Cost           *big.Rat
+	// cost of the namespace and of all namespaces under it
+	SubtreeCost *big.Rat
+}
+
+type CostGroup struct {
+	// value of the label the namespaces are grouped by, empty for the namespaces without the label
+	Value      string
+	Namespaces []string
+	Cost       *big.Rat
+}
+
+// CostReport charges the namespaces of a subtree for the quotas allocated to them over a time range.
+// The quotas of a namespace cover the quotas of its child namespaces, so each namespace is charged
+// only for what it keeps, and the subtree costs roll the charges up along the hierarchy.
+type CostReport struct {
+	OrgId string
+	From  time.Time
+	To    time.Time
+	// parents before their children
+	Namespaces []NamespaceCost
+	// empty if the namespaces are not grouped
+	GroupBy string
+	// in the order of the label values
+	Groups []CostGroup
+	Total  *big.Rat
+	// resources that were allocated, but have no price
+	Unpriced []string
+}
+
+// NewCostReport computes the costs of the namespaces in the tree from the milli unit hours allocated
+// to each of them, by namespace id.
+func NewCostReport(orgId string, tree NamespaceTree, allocated map[string]map[string]*big.Rat, prices []ResourcePrice, from, to time.Time, groupBy string) CostReport {
+	report := CostReport{
+		OrgId:   orgId,
+		From:    from,
+		To:      to,
+		GroupBy: groupBy,
+	}
+	pricesByResource := make(map[string]ResourcePrice)
+	for _, price := range prices {
+		pricesByResource[price.Resource] = price
+	}
+	var visit func(node *NamespaceTreeNode, parent string) *big.Rat
+	visit = func(node *NamespaceTreeNode, parent string) *big.Rat {
+		own := make(map[string]*big.Rat)
+		for resource, hours := range allocated[node.Namespace.GetId()] {
+			own[resource] = new(big.Rat).Set(hours)
+		}
+		for _, child := range node.Children {
+			for resource, hours := range allocated[child.Namespace.GetId()] {
+				if kept, found := own[resource]; found {
+					kept.Sub(kept, hours)
+				}
+			}
+		}
+		cost := NamespaceCost{
+			Name:           node.Namespace.GetName(),
+			Parent:         parent,
+			Labels:         node.Namespace.GetLabels(),
+			MilliUnitHours: make(map[string]*big.Rat),
+			Cost:           new(big.Rat),
+		}
+		for resource, hours := range own {
+			// overcommitted namespaces hand out more than they have
+			if hours.Sign() <= 0 {
+				continue
+			}
+			cost.MilliUnitHours[resource] = hours
+			price, found := pricesByResource[resource]
+			if !found {
+				if !slices.Contains(report.Unpriced, resource) {
+					report.Unpriced = append(report.Unpriced, resource)
+				}
+				continue
+			}
+			cost.Cost.Add(cost.Cost, price.Cost(hours))
+		}
+		index := len(report.Namespaces)
+		report.Namespaces = append(report.Namespaces, cost)
+		subtreeCost := new(big.Rat).Set(cost.Cost)
+		for _, child := range node.Children {
+			subtreeCost.Add(subtreeCost, visit(child, cost.Name))
+		}
+		report.Namespaces[index].SubtreeCost = subtreeCost
+		return subtreeCost
+	}
+	report.Total = visit(&tree.Root, "")
+	slices.Sort(report.Unpriced)
+	if groupBy != "" {
+		report.Groups = groupCosts(report.Namespaces, groupBy)
+	}
+	return report
+}
+
+func groupCosts(namespaces []NamespaceCost, label string) []CostGroup {
+	groups := make([]CostGroup, 0)
+	for _, namespace := range namespaces {
+		value := namespace.Labels[label]
+		i := slices.IndexFunc(groups, func(group CostGroup) bool {
+			return group.Value == value
+		})
+		if i < 0 {
+			groups = append(groups, CostGroup{Value: value, Cost: new(big.Rat)})
+			i = len(groups) - 1
+		}
+		groups[i].Namespaces = append(groups[i].Namespaces, namespace.Name)
+		groups[i].Cost.Add(groups[i].Cost, namespace.Cost)
+	}
+	slices.SortFunc(groups, func(a, b CostGroup) int {
+		return strings.Compare(a.Value, b.Value)
+	})
+	return groups
+}
+
+// FormatCost rounds the cost to cents.
+func FormatCost(cost *big.Rat) string {
+	return cost.FloatString(2)
+}
+
+// FormatUnitHours converts milli unit hours into unit hours of the resource type, such as cpu-hours.
+func FormatUnitHours(milliUnitHours *big.Rat) string {
+	return new(big.Rat).Quo(milliUnitHours, big.NewRat(MilliUnits, 1)).FloatString(3)
+}
+
+// resources returns the names of the resources any namespace of the report was charged for.
+func (r CostReport) resources() []string {
+	resources := make([]string, 0)
+	for _, namespace := range r.Namespaces {
+		for resource := range namespace.MilliUnitHours {
+			if !slices.Contains(resources, resource) {
+				resources = append(resources, resource)
+			}
+		}
+	}
+	slices.Sort(resources)
+	return resources
+}
+
+// CSV returns a row for each namespace, with the unit hours of each resource as a column of its own.
+func (r CostReport) CSV() ([]byte, error) {
+	resources := r.resources()
+	header := []string{"namespace", "parent"}
+	if r.GroupBy != "" {
+		header = append(header, r.GroupBy)
+	}
+	for _, resource := range resources {
+		header = append(header, resource+"_hours")
+	}
+	header = append(header, "cost", "subtree_cost")
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	err := writer.Write(header)
+	if err != nil {
+		return nil, err
+	}
+	for _, namespace := range r.Namespaces {
+		row := []string{namespace.Name, namespace.Parent}
+		if r.GroupBy != "" {
+			row = append(row, namespace.Labels[r.GroupBy])
+		}
+		for _, resource := range resources {
+			hours, found := namespace.MilliUnitHours[resource]
+			if !found {
+				hours = new(big.Rat)
+			}
+			row = append(row, FormatUnitHours(hours))
+		}
+		row = append(row, FormatCost(namespace.Cost), FormatCost(namespace.SubtreeCost))
+		err := writer.Write(row)
+		if err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+func (r CostReport) JSON() ([]byte, error) {
+	type namespaceJson struct {
+		Name        string            `json:"name"`
+		Parent      string            `json:"parent,omitempty"`
+		Labels      map[string]string `json:"labels,omitempty"`
+		UnitHours   map[string]string `json:"unit_hours"`
+		Cost        string            `json:"cost"`
+		SubtreeCost string            `json:"subtree_cost"`
+	}
+	type groupJson struct {
+		Value      string   `json:"value"`
+		Namespaces []string `json:"namespaces"`
+		Cost       string   `json:"cost"`
+	}
+	report := struct {
+		OrgId      string          `json:"org_id"`
+		From       time.Time       `json:"from"`
+		To         time.Time       `json:"to"`
+		Namespaces []namespaceJson `json:"namespaces"`
+		GroupBy    string          `json:"group_by,omitempty"`
+		Groups     []groupJson     `json:"groups,omitempty"`
+		Total      string          `json:"total"`
+		Unpriced   []string        `json:"unpriced,omitempty"`
+	}{
+		OrgId:      r.OrgId,
+		From:       r.From.UTC(),
+		To:         r.To.UTC(),
+		Namespaces: make([]namespaceJson, 0, len(r.Namespaces)),
+		GroupBy:    r.GroupBy,
+		Total:      FormatCost(r.Total),
+		Unpriced:   r.Unpriced,
+	}
+	for _, namespace := range r.Namespaces {
+		unitHours := make(map[string]string)
+		for resource, hours := range namespace.MilliUnitHours {
+			unitHours[resource] = FormatUnitHours(hours)
+		}
+		report.Namespaces = append(report.Namespaces, namespaceJson{
+			Name:        namespace.Name,
+			Parent:      namespace.Parent,
+			Labels:      namespace.Labels,
+			UnitHours:   unitHours,
+			Cost:        FormatCost(namespace.Cost),
+			SubtreeCost: FormatCost(namespace.SubtreeCost),
+		})
+	}
+	for _, group := range r.Groups {
+		report.Groups = append(report.Groups, groupJson{
+			Value:      group.Value,
+			Namespaces: group.Namespaces,
+			Cost:       FormatCost(group.Cost),
+		})
+	}
+	return json.MarshalIndent(report, "", "\t")
+}
@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAllocatedMilliUnitHours(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(4 * time.Hour)
+	tests := []struct {
+		name    string
+		samples []QuotaSample
+		want    map[string]string
+	}{
+		{
+			name: "quota set before the range",
+			samples: []QuotaSample{
+				{Resource: "cpu", Allocated: 1000, Timestamp: from.Add(-time.Hour)},
+			},
+			want: map[string]string{"cpu": "4000"},
+		},
+		{
+			name: "quota changed within the range",
+			samples: []QuotaSample{
+				{Resource: "cpu", Allocated: 3000, Timestamp: from.Add(2 * time.Hour)},
+				{Resource: "cpu", Allocated: 1000, Timestamp: from.Add(-time.Hour)},
+			},
+			want: map[string]string{"cpu": "8000"},
+		},
+		{
+			name: "entity removed within the range",
+			samples: []QuotaSample{
+				{Resource: "cpu", Allocated: 1000, Timestamp: from},
+				{Resource: "cpu", Allocated: 0, Timestamp: from.Add(90 * time.Minute)},
+			},
+			want: map[string]string{"cpu": "1500"},
+		},
+		{
+			name: "quota set after the range",
+			samples: []QuotaSample{
+				{Resource: "cpu", Allocated: 1000, Timestamp: from},
+				{Resource: "mem", Allocated: 512000, Timestamp: to.Add(time.Hour)},
+			},
+			want: map[string]string{"cpu": "4000", "mem": "0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[string]string)
+			for resource, hours := range AllocatedMilliUnitHours(tt.samples, from, to) {
+				got[resource] = hours.RatString()
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AllocatedMilliUnitHours() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstantMilliUnitHours(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	hours := ConstantMilliUnitHours(ResourceQuotas{"cpu": 2000}, from, from.Add(3*time.Hour))
+	if got := hours["cpu"].RatString(); got != "6000" {
+		t.Errorf("ConstantMilliUnitHours() = %s cpu milli unit hours, want 6000", got)
+	}
+}
+
+func TestNewCostReport(t *testing.T) {
+	root := NewNamespace("org", "default", "", map[string]string{"team": "a"})
+	x := NewNamespace("org", "x", "", map[string]string{"team": "b"})
+	y := NewNamespace("org", "y", "", nil)
+	tree := NamespaceTree{Root: NamespaceTreeNode{
+		Namespace: &root,
+		Children:  []*NamespaceTreeNode{{Namespace: &x}, {Namespace: &y}},
+	}}
+	// 0.04 per cpu-hour, mem has no price
+	prices := []ResourcePrice{{OrgId: "org", Resource: "cpu", Per: 1000, PerHour: "0.04"}}
+	hours := func(values map[string]int64) map[string]*big.Rat {
+		rats := make(map[string]*big.Rat)
+		for resource, value := range values {
+			rats[resource] = big.NewRat(value, 1)
+		}
+		return rats
+	}
+	tests := []struct {
+		name             string
+		allocated        map[string]map[string]*big.Rat
+		groupBy          string
+		wantCosts        map[string]string
+		wantSubtreeCosts map[string]string
+		wantTotal        string
+		wantUnpriced     []string
+		wantGroups       map[string]string
+	}{
+		{
+			name: "parents are charged for what they keep",
+			allocated: map[string]map[string]*big.Rat{
+				root.GetId(): hours(map[string]int64{"cpu": 100000, "mem": 5000}),
+				x.GetId():    hours(map[string]int64{"cpu": 40000}),
+				y.GetId():    hours(map[string]int64{"cpu": 20000}),
+			},
+			wantCosts:        map[string]string{"default": "1.60", "x": "1.60", "y": "0.80"},
+			wantSubtreeCosts: map[string]string{"default": "4.00", "x": "1.60", "y": "0.80"},
+			wantTotal:        "4.00",
+			wantUnpriced:     []string{"mem"},
+		},
+		{
+			name: "overcommitted parent",
+			allocated: map[string]map[string]*big.Rat{
+				root.GetId(): hours(map[string]int64{"cpu": 10000}),
+				x.GetId():    hours(map[string]int64{"cpu": 20000}),
+			},
+			wantCosts:        map[string]string{"default": "0.00", "x": "0.80", "y": "0.00"},
+			wantSubtreeCosts: map[string]string{"default": "0.80", "x": "0.80", "y": "0.00"},
+			wantTotal:        "0.80",
+		},
+		{
+			name: "grouped by label",
+			allocated: map[string]map[string]*big.Rat{
+				root.GetId(): hours(map[string]int64{"cpu": 100000}),
+				x.GetId():    hours(map[string]int64{"cpu": 40000}),
+				y.GetId():    hours(map[string]int64{"cpu": 20000}),
+			},
+			groupBy:          "team",
+			wantCosts:        map[string]string{"default": "1.60", "x": "1.60", "y": "0.80"},
+			wantSubtreeCosts: map[string]string{"default": "4.00", "x": "1.60", "y": "0.80"},
+			wantTotal:        "4.00",
+			wantGroups:       map[string]string{"": "0.80", "a": "1.60", "b": "1.60"},
+		},
+		{
+			name:             "nothing allocated",
+			allocated:        map[string]map[string]*big.Rat{},
+			wantCosts:        map[string]string{"default": "0.00", "x": "0.00", "y": "0.00"},
+			wantSubtreeCosts: map[string]string{"default": "0.00", "x": "0.00", "y": "0.00"},
+			wantTotal:        "0.00",
+		},
+	}
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := NewCostReport("org", tree, tt.allocated, prices, from, from.Add(10*time.Hour), tt.groupBy)
+			costs := make(map[string]string)
+			subtreeCosts := make(map[string]string)
+			for _, namespace := range report.Namespaces {
+				costs[namespace.Name] = FormatCost(namespace.Cost)
+				subtreeCosts[namespace.Name] = FormatCost(namespace.SubtreeCost)
+			}
+			if !reflect.DeepEqual(costs, tt.wantCosts) {
+				t.Errorf("costs = %v, want %v", costs, tt.wantCosts)
+			}
+			if !reflect.DeepEqual(subtreeCosts, tt.wantSubtreeCosts) {
+				t.Errorf("subtree costs = %v, want %v", subtreeCosts, tt.wantSubtreeCosts)
+			}
+			if got := FormatCost(report.Total); got != tt.wantTotal {
+				t.Errorf("total = %s, want %s", got, tt.wantTotal)
+			}
+			if !reflect.DeepEqual(report.Unpriced, tt.wantUnpriced) {
+				t.Errorf("unpriced = %v, want %v", report.Unpriced, tt.wantUnpriced)
+			}
+			if tt.wantGroups == nil {
+				if len(report.Groups) > 0 {
+					t.Errorf("groups = %v, want none", report.Groups)
+				}
+				return
+			}
+			groups := make(map[string]string)
+			for i, group := range report.Groups {
+				if i > 0 && report.Groups[i-1].Value > group.Value {
+					t.Errorf("group %q is listed after %q", group.Value, report.Groups[i-1].Value)
+				}
+				groups[group.Value] = FormatCost(group.Cost)
+			}
+			if !reflect.DeepEqual(groups, tt.wantGroups) {
+				t.Errorf("groups = %v, want %v", groups, tt.wantGroups)
+			}
+		})
+	}
+}
+
+func TestCostReportCSV(t *testing.T) {
+	report := CostReport{
+		Namespaces: []NamespaceCost{{
+			Name:           "default",
+			Labels:         map[string]string{"team": "a"},
+			MilliUnitHours: map[string]*big.Rat{"cpu": big.NewRat(40000, 1), "mem": big.NewRat(1500, 1)},
+			Cost:           big.NewRat(8, 5),
+			SubtreeCost:    big.NewRat(4, 1),
+		}},
+		GroupBy: "team",
+		Total:   big.NewRat(4, 1),
+	}
+	got, err := report.CSV()
+	if err != nil {
+		t.Fatalf("CSV() error = %v", err)
+	}
+	want := "namespace,parent,team,cpu_hours,mem_hours,cost,subtree_cost\n" +
+		"default,,a,40.000,1.500,1.60,4.00\n"
+	if string(got) != want {
+		t.Errorf("CSV() = %q, want %q", got, want)
+	}
+}
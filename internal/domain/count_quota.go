@@ -0,0 +1,46 @@
+package domain
+
+import "fmt"
+
+// ObjectCounts holds the number of apps and namespaces in the subtree of a namespace,
+// the namespace itself not included.
+type ObjectCounts struct {
+	Apps       int64
+	Namespaces int64
+}
+
+func (c ObjectCounts) Add(other ObjectCounts) ObjectCounts {
+	return ObjectCounts{
+		Apps:       c.Apps + other.Apps,
+		Namespaces: c.Namespaces + other.Namespaces,
+	}
+}
+
+// CountQuotas limits how many apps and namespaces the subtree of a namespace can hold.
+// Zero means no limit. The limits of all ancestors apply as well, like resource quotas do.
+type CountQuotas struct {
+	MaxApps       int64
+	MaxNamespaces int64
+}
+
+func (q CountQuotas) Validate() error {
+	if q.MaxApps < 0 || q.MaxNamespaces < 0 {
+		return fmt.Errorf("count quotas must not be negative")
+	}
+	return nil
+}
+
+func (q CountQuotas) IsLimited() bool {
+	return q.MaxApps > 0 || q.MaxNamespaces > 0
+}
+
+// Check returns an error if the counts exceed the count quotas.
+func (q CountQuotas) Check(counts ObjectCounts) error {
+	if q.MaxApps > 0 && counts.Apps > q.MaxApps {
+		return fmt.Errorf("%d apps exceed the count quota of %d apps", counts.Apps, q.MaxApps)
+	}
+	if q.MaxNamespaces > 0 && counts.Namespaces > q.MaxNamespaces {
+		return fmt.Errorf("%d child namespaces exceed the count quota of %d namespaces", counts.Namespaces, q.MaxNamespaces)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr bool
+	}{
+		{"* * * * *", false},
+		{"*/15 * * * *", false},
+		{"0 9 * * 1-5", false},
+		{"0,30 8-18/2 1,15 */3 *", false},
+		{"0 0 * * 7", false},
+		{"0 0 29 2 *", false},
+		{"0 0 30 2 *", true},
+		{"0 0 31 4 *", true},
+		{"* * * *", true},
+		{"* * * * * *", true},
+		{"60 * * * *", true},
+		{"* 24 * * *", true},
+		{"* * 0 * *", true},
+		{"* * * 13 *", true},
+		{"* * * * 8", true},
+		{"*/0 * * * *", true},
+		{"5-1 * * * *", true},
+		{"a * * * *", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseCron(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCron(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	// a saturday
+	after := time.Date(2026, 10, 17, 10, 7, 30, 0, time.UTC)
+	tests := []struct {
+		name  string
+		expr  string
+		after time.Time
+		want  time.Time
+	}{
+		{"every minute", "* * * * *", after, time.Date(2026, 10, 17, 10, 8, 0, 0, time.UTC)},
+		{"step", "*/15 * * * *", after, time.Date(2026, 10, 17, 10, 15, 0, 0, time.UTC)},
+		{"later today", "30 10 * * *", after, time.Date(2026, 10, 17, 10, 30, 0, 0, time.UTC)},
+		{"tomorrow", "0 10 * * *", after, time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)},
+		{"exactly on a match", "7 10 * * *", time.Date(2026, 10, 17, 10, 7, 0, 0, time.UTC), time.Date(2026, 10, 18, 10, 7, 0, 0, time.UTC)},
+		{"weekdays", "0 9 * * 1-5", after, time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)},
+		{"sunday as 0", "0 0 * * 0", after, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"sunday as 7", "0 0 * * 7", after, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"day of month", "0 0 20 * *", after, time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)},
+		{"day of month or day of week", "0 0 13 * 5", after, time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC)},
+		{"day of month before day of week", "0 0 19 * 5", after, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{"next month", "0 0 1 * *", after, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"next year", "0 12 * 1 *", after, time.Date(2027, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{"leap day", "0 0 29 2 *", after, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"other time zone", "30 10 * * *", after.In(time.FixedZone("CEST", 2*60*60)), time.Date(2026, 10, 17, 10, 30, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCron(%q) error = %v", tt.expr, err)
+			}
+			if got := schedule.Next(tt.after); !got.Equal(tt.want) {
+				t.Errorf("Next(%v) of %q = %v, want %v", tt.after, tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCronNextNeverMatches(t *testing.T) {
+	// 0 0 30 2 *, which ParseCron rejects
+	schedule := CronSchedule{
+		expr:           "0 0 30 2 *",
+		minutes:        1,
+		hours:          1,
+		days:           1 << 30,
+		months:         1 << 2,
+		weekdays:       1<<8 - 1,
+		daysRestricted: true,
+	}
+	if got := schedule.Next(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)); !got.IsZero() {
+		t.Errorf("Next() = %v, want the zero time", got)
+	}
+}
@@ -0,0 +1,45 @@
+package domain
+
+// ResourceUsage holds what the available quota of a resource in an entity is computed from.
+// An entity with an elastic max above its quota can borrow the capacity its sibling
+// namespaces do not hand out to their children. Borrowed capacity stays reclaimable,
+// since the available quota of an entity never drops below its own unused quota.
+type ResourceUsage struct {
+	// quota of the entity, overcommit included
+	Total int64
+	// sum of the quotas of the children
+	Utilized int64
+	// zero if the entity cannot borrow
+	ElasticMax int64
+	// total quota minus utilized quota of the sibling namespaces, capacity they borrowed is subtracted
+	SiblingSpare int64
+}
+
+// ceiling returns how much the children of the entity can have in total.
+func (u ResourceUsage) ceiling() int64 {
+	return max(u.Total, u.ElasticMax)
+}
+
+// Available returns how much more the children of the entity can have.
+func (u ResourceUsage) Available() int64 {
+	own := u.Total - u.Utilized
+	if u.ceiling() == u.Total {
+		return own
+	}
+	burst := min(u.ceiling()-u.Utilized, max(own, 0)+max(u.SiblingSpare, 0))
+	return max(own, burst)
+}
+
+// Borrowed returns the capacity the entity borrowed from its siblings.
+func (u ResourceUsage) Borrowed() int64 {
+	return max(u.Utilized-u.Total, 0)
+}
+
+// Fits tells if the quotas of the children are covered by the total quota
+// and by what can be borrowed from the siblings.
+func (u ResourceUsage) Fits() bool {
+	if u.Utilized <= u.Total {
+		return true
+	}
+	return u.Utilized <= u.ElasticMax && u.Borrowed() <= max(u.SiblingSpare, 0)
+}
@@ -0,0 +1,98 @@
+package domain
+
+import "testing"
+
+func TestResourceUsage(t *testing.T) {
+	tests := []struct {
+		name          string
+		usage         ResourceUsage
+		wantAvailable int64
+		wantBorrowed  int64
+		wantFits      bool
+	}{
+		{
+			name:          "without elastic max",
+			usage:         ResourceUsage{Total: 10, Utilized: 4, SiblingSpare: 5},
+			wantAvailable: 6,
+			wantFits:      true,
+		},
+		{
+			name:          "fully utilized",
+			usage:         ResourceUsage{Total: 10, Utilized: 10},
+			wantAvailable: 0,
+			wantFits:      true,
+		},
+		{
+			name:          "over utilized without elastic max",
+			usage:         ResourceUsage{Total: 10, Utilized: 12, SiblingSpare: 5},
+			wantAvailable: -2,
+			wantBorrowed:  2,
+			wantFits:      false,
+		},
+		{
+			name:          "elastic max below total",
+			usage:         ResourceUsage{Total: 10, Utilized: 4, ElasticMax: 5, SiblingSpare: 50},
+			wantAvailable: 6,
+			wantFits:      true,
+		},
+		{
+			name:          "limited by elastic max",
+			usage:         ResourceUsage{Total: 10, Utilized: 4, ElasticMax: 20, SiblingSpare: 50},
+			wantAvailable: 16,
+			wantFits:      true,
+		},
+		{
+			name:          "limited by sibling spare",
+			usage:         ResourceUsage{Total: 10, Utilized: 4, ElasticMax: 20, SiblingSpare: 3},
+			wantAvailable: 9,
+			wantFits:      true,
+		},
+		{
+			name:          "negative sibling spare keeps own quota",
+			usage:         ResourceUsage{Total: 10, Utilized: 4, ElasticMax: 20, SiblingSpare: -5},
+			wantAvailable: 6,
+			wantFits:      true,
+		},
+		{
+			name:          "borrowing",
+			usage:         ResourceUsage{Total: 10, Utilized: 15, ElasticMax: 20, SiblingSpare: 5},
+			wantAvailable: 5,
+			wantBorrowed:  5,
+			wantFits:      true,
+		},
+		{
+			name:          "borrowing more than siblings spare",
+			usage:         ResourceUsage{Total: 10, Utilized: 15, ElasticMax: 20, SiblingSpare: 4},
+			wantAvailable: 4,
+			wantBorrowed:  5,
+			wantFits:      false,
+		},
+		{
+			name:          "borrowing from siblings without spare",
+			usage:         ResourceUsage{Total: 10, Utilized: 15, ElasticMax: 20, SiblingSpare: -1},
+			wantAvailable: 0,
+			wantBorrowed:  5,
+			wantFits:      false,
+		},
+		{
+			name:          "utilized above elastic max",
+			usage:         ResourceUsage{Total: 10, Utilized: 25, ElasticMax: 20, SiblingSpare: 50},
+			wantAvailable: -5,
+			wantBorrowed:  15,
+			wantFits:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.usage.Available(); got != tt.wantAvailable {
+				t.Errorf("Available() = %d, want %d", got, tt.wantAvailable)
+			}
+			if got := tt.usage.Borrowed(); got != tt.wantBorrowed {
+				t.Errorf("Borrowed() = %d, want %d", got, tt.wantBorrowed)
+			}
+			if got := tt.usage.Fits(); got != tt.wantFits {
+				t.Errorf("Fits() = %v, want %v", got, tt.wantFits)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type LabelOperator string
+
+const (
+	LabelEquals       LabelOperator = "="
+	LabelNotEquals    LabelOperator = "!="
+	LabelIn           LabelOperator = "in"
+	LabelNotIn        LabelOperator = "notin"
+	LabelExists       LabelOperator = "exists"
+	LabelDoesNotExist LabelOperator = "!"
+)
+
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Values   []string
+}
+
+func (r LabelRequirement) Matches(labels map[string]string) bool {
+	value, found := labels[r.Key]
+	switch r.Operator {
+	case LabelEquals, LabelIn:
+		return found && slices.Contains(r.Values, value)
+	case LabelNotEquals, LabelNotIn:
+		return !found || !slices.Contains(r.Values, value)
+	case LabelExists:
+		return found
+	case LabelDoesNotExist:
+		return !found
+	}
+	return false
+}
+
+// LabelSelector matches labels that satisfy all of its requirements.
+// An empty selector matches everything.
+type LabelSelector []LabelRequirement
+
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, requirement := range s {
+		if !requirement.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseLabelSelector parses comma separated requirements in the kubernetes
+// label selector syntax: key=value, key==value, key!=value, key in (a,b),
+// key notin (a,b), key and !key.
+func ParseLabelSelector(selector string) (LabelSelector, error) {
+	requirements := make(LabelSelector, 0)
+	for _, term := range splitSelector(selector) {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		requirement, err := parseLabelRequirement(term)
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements, nil
+}
+
+// splitSelector splits the selector by commas that are not enclosed in parentheses.
+func splitSelector(selector string) []string {
+	terms := make([]string, 0)
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(terms, selector[start:])
+}
+
+func parseLabelRequirement(term string) (LabelRequirement, error) {
+	if key, found := strings.CutPrefix(term, "!"); found {
+		return newLabelRequirement(strings.TrimSpace(key), LabelDoesNotExist, nil)
+	}
+	if key, value, found := strings.Cut(term, "!="); found {
+		return newLabelRequirement(strings.TrimSpace(key), LabelNotEquals, []string{strings.TrimSpace(value)})
+	}
+	if key, value, found := strings.Cut(term, "=="); found {
+		return newLabelRequirement(strings.TrimSpace(key), LabelEquals, []string{strings.TrimSpace(value)})
+	}
+	if key, value, found := strings.Cut(term, "="); found {
+		return newLabelRequirement(strings.TrimSpace(key), LabelEquals, []string{strings.TrimSpace(value)})
+	}
+	fields := strings.Fields(term)
+	if len(fields) == 1 {
+		return newLabelRequirement(fields[0], LabelExists, nil)
+	}
+	key := fields[0]
+	operator, set, found := strings.Cut(strings.TrimSpace(strings.TrimPrefix(term, key)), " ")
+	if !found || (operator != string(LabelIn) && operator != string(LabelNotIn)) {
+		return LabelRequirement{}, fmt.Errorf("invalid label selector requirement %s", term)
+	}
+	set = strings.TrimSpace(set)
+	if !strings.HasPrefix(set, "(") || !strings.HasSuffix(set, ")") {
+		return LabelRequirement{}, fmt.Errorf("values of label selector requirement %s must be enclosed in parentheses", term)
+	}
+	values := make([]string, 0)
+	for _, value := range strings.Split(set[1:len(set)-1], ",") {
+		values = append(values, strings.TrimSpace(value))
+	}
+	return newLabelRequirement(key, LabelOperator(operator), values)
+}
+
+func newLabelRequirement(key string, operator LabelOperator, values []string) (LabelRequirement, error) {
+	if !labelKeyRegex.MatchString(key) {
+		return LabelRequirement{}, fmt.Errorf("invalid label key %s in label selector", key)
+	}
+	for _, value := range values {
+		if !labelValueRegex.MatchString(value) {
+			return LabelRequirement{}, fmt.Errorf("invalid value %s for label %s in label selector", value, key)
+		}
+	}
+	return LabelRequirement{
+		Key:      key,
+		Operator: operator,
+		Values:   values,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		want     LabelSelector
+		wantErr  bool
+	}{
+		{
+			name:     "empty",
+			selector: "",
+			want:     LabelSelector{},
+		},
+		{
+			name:     "equals",
+			selector: "env=prod",
+			want:     LabelSelector{{Key: "env", Operator: LabelEquals, Values: []string{"prod"}}},
+		},
+		{
+			name:     "double equals",
+			selector: "env==prod",
+			want:     LabelSelector{{Key: "env", Operator: LabelEquals, Values: []string{"prod"}}},
+		},
+		{
+			name:     "not equals",
+			selector: "env != prod",
+			want:     LabelSelector{{Key: "env", Operator: LabelNotEquals, Values: []string{"prod"}}},
+		},
+		{
+			name:     "in",
+			selector: "env in (prod, staging)",
+			want:     LabelSelector{{Key: "env", Operator: LabelIn, Values: []string{"prod", "staging"}}},
+		},
+		{
+			name:     "notin",
+			selector: "env notin (dev)",
+			want:     LabelSelector{{Key: "env", Operator: LabelNotIn, Values: []string{"dev"}}},
+		},
+		{
+			name:     "exists",
+			selector: "team",
+			want:     LabelSelector{{Key: "team", Operator: LabelExists}},
+		},
+		{
+			name:     "does not exist",
+			selector: "!team",
+			want:     LabelSelector{{Key: "team", Operator: LabelDoesNotExist}},
+		},
+		{
+			name:     "several requirements",
+			selector: "env in (prod,staging), !legacy, tier=web",
+			want: LabelSelector{
+				{Key: "env", Operator: LabelIn, Values: []string{"prod", "staging"}},
+				{Key: "legacy", Operator: LabelDoesNotExist},
+				{Key: "tier", Operator: LabelEquals, Values: []string{"web"}},
+			},
+		},
+		{
+			name:     "prefixed key",
+			selector: "example.com/owner=ops",
+			want:     LabelSelector{{Key: "example.com/owner", Operator: LabelEquals, Values: []string{"ops"}}},
+		},
+		{
+			name:     "unknown operator",
+			selector: "env within (prod)",
+			wantErr:  true,
+		},
+		{
+			name:     "values without parentheses",
+			selector: "env in prod",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid key",
+			selector: "-env=prod",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid value",
+			selector: "env=pr od",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLabelSelector(tt.selector)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLabelSelector(%q) error = %v, wantErr %v", tt.selector, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLabelSelector(%q) = %v, want %v", tt.selector, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLabelSelectorMatches(t *testing.T) {
+	labels := map[string]string{"env": "prod", "team": "ops"}
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"", true},
+		{"env=prod", true},
+		{"env==staging", false},
+		{"env!=staging", true},
+		{"missing!=x", true},
+		{"env in (staging,prod)", true},
+		{"env notin (staging,prod)", false},
+		{"missing notin (x)", true},
+		{"team", true},
+		{"!team", false},
+		{"!missing", true},
+		{"env=prod,team=dev", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			selector, err := ParseLabelSelector(tt.selector)
+			if err != nil {
+				t.Fatalf("ParseLabelSelector(%q) error = %v", tt.selector, err)
+			}
+			if got := selector.Matches(labels); got != tt.want {
+				t.Errorf("%q matches %v = %v, want %v", tt.selector, labels, got, tt.want)
+			}
+		})
+	}
+}
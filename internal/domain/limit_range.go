@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"math/big"
+)
+
+// ResourceRatio limits how much of a resource an app can have per unit of another resource, e.g. mem per cpu.
+type ResourceRatio struct {
+	Resource    string
+	PerResource string
+	// in milli units of the resource per unit of the other resource
+	Max int64
+}
+
+// LimitRange bounds the quotas of the apps in a namespace.
+type LimitRange struct {
+	// quotas of apps that are created without them
+	Defaults  ResourceQuotas
+	Min       ResourceQuotas
+	Max       ResourceQuotas
+	MaxRatios []ResourceRatio
+}
+
+func NewLimitRange() LimitRange {
+	return LimitRange{
+		Defaults:  make(ResourceQuotas),
+		Min:       make(ResourceQuotas),
+		Max:       make(ResourceQuotas),
+		MaxRatios: make([]ResourceRatio, 0),
+	}
+}
+
+func (l LimitRange) Clone() LimitRange {
+	clone := NewLimitRange()
+	maps.Copy(clone.Defaults, l.Defaults)
+	maps.Copy(clone.Min, l.Min)
+	maps.Copy(clone.Max, l.Max)
+	clone.MaxRatios = append(clone.MaxRatios, l.MaxRatios...)
+	return clone
+}
+
+// Validate checks that the limit range can be satisfied, defaults included.
+func (l LimitRange) Validate(orgId string, resourceTypes *ResourceTypeRegistry) error {
+	for _, quotas := range []ResourceQuotas{l.Defaults, l.Min, l.Max} {
+		for resource, quota := range quotas {
+			if err := resourceTypes.ValidateResourceQuota(orgId, resource, quota); err != nil {
+				return err
+			}
+		}
+	}
+	for resource, min := range l.Min {
+		if max, found := l.Max[resource]; found && min > max {
+			return fmt.Errorf("min quota for the resource %s is greater than the max quota", resource)
+		}
+	}
+	for _, ratio := range l.MaxRatios {
+		for _, resource := range []string{ratio.Resource, ratio.PerResource} {
+			if _, found := resourceTypes.Get(orgId, resource); !found {
+				return fmt.Errorf("quotas for a resource with name %s are not supported", resource)
+			}
+		}
+		if ratio.Resource == ratio.PerResource {
+			return fmt.Errorf("max ratio of the resource %s must be set per another resource", ratio.Resource)
+		}
+		if ratio.Max < 0 {
+			return fmt.Errorf("max ratio of the resource %s per %s must not be negative", ratio.Resource, ratio.PerResource)
+		}
+	}
+	if err := l.Check(l.Defaults); err != nil {
+		return fmt.Errorf("defaults are out of the limit range: %w", err)
+	}
+	return nil
+}
+
+// ApplyDefaults returns the quotas with the defaults added for the resources that are missing.
+func (l LimitRange) ApplyDefaults(quotas ResourceQuotas) ResourceQuotas {
+	applied := make(ResourceQuotas)
+	for resource, quota := range l.Defaults {
+		applied[resource] = quota
+	}
+	for resource, quota := range quotas {
+		applied[resource] = quota
+	}
+	return applied
+}
+
+// Check returns an error if app quotas are out of the limit range.
+// Missing quotas count as zero.
+func (l LimitRange) Check(quotas ResourceQuotas) error {
+	for resource, min := range l.Min {
+		if quota := quotas[resource]; quota < min {
+			return fmt.Errorf("quota %s for the resource %s is below the min %s", FormatMilliUnits(quota), resource, FormatMilliUnits(min))
+		}
+	}
+	for resource, max := range l.Max {
+		if quota := quotas[resource]; quota > max {
+			return fmt.Errorf("quota %s for the resource %s is above the max %s", FormatMilliUnits(quota), resource, FormatMilliUnits(max))
+		}
+	}
+	for _, ratio := range l.MaxRatios {
+		quota, per := quotas[ratio.Resource], quotas[ratio.PerResource]
+		// quota / (per / MilliUnits) > max, without dividing
+		left := new(big.Int).Mul(big.NewInt(quota), big.NewInt(MilliUnits))
+		right := new(big.Int).Mul(big.NewInt(ratio.Max), big.NewInt(per))
+		if left.Cmp(right) > 0 {
+			return fmt.Errorf("quota of the resource %s exceeds the max of %s per unit of the resource %s", ratio.Resource, FormatMilliUnits(ratio.Max), ratio.PerResource)
+		}
+	}
+	return nil
+}
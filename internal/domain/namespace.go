@@ -3,9 +3,8 @@ package domain
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"maps"
-	"slices"
+	"regexp"
 )
 
 const (
@@ -21,15 +20,39 @@ type SeccompProfile struct {
 	Architecture string
 }
 
+var (
+	labelKeyRegex   = regexp.MustCompile(`^([a-z0-9]([-a-z0-9.]*[a-z0-9])?/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	labelValueRegex = regexp.MustCompile(`^([A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?)?$`)
+)
+
 func MakeNamespaceId(orgId, namespaceName string) string {
 	return fmt.Sprintf("%s/%s", orgId, namespaceName)
 }
 
+// ValidateLabels checks that label keys and values can be used in label selectors.
+func ValidateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if !labelKeyRegex.MatchString(key) {
+			return fmt.Errorf("invalid label key %s", key)
+		}
+		if !labelValueRegex.MatchString(value) {
+			return fmt.Errorf("invalid value %s for label %s", value, key)
+		}
+	}
+	return nil
+}
+
 type Namespace struct {
 	orgId          string
 	name           string
 	resourceQuotas ResourceQuotas
 	available      ResourceQuotas
+	utilized       ResourceQuotas
+	elasticMax     ResourceQuotas
+	overcommits    Overcommits
+	limitRange     LimitRange
+	countQuotas    CountQuotas
+	thresholds     UtilizationThresholds
 	profileVersion string
 	labels         map[string]string
 }
@@ -42,6 +65,11 @@ func NewNamespace(orgId, name, profileVersion string, labels map[string]string)
 		labels:         labels,
 		resourceQuotas: make(ResourceQuotas, 0),
 		available:      make(ResourceQuotas, 0),
+		utilized:       make(ResourceQuotas),
+		elasticMax:     make(ResourceQuotas),
+		overcommits:    make(Overcommits),
+		limitRange:     NewLimitRange(),
+		thresholds:     make(UtilizationThresholds),
 	}
 }
 
@@ -59,9 +87,11 @@ func (n Namespace) GetResourceQuotas() ResourceQuotas {
 	return quotas
 }
 
-func (n *Namespace) AddResourceQuota(resource string, quota float64) error {
-	if !slices.Contains(SupportedResourceQuotas, resource) {
-		return fmt.Errorf("quotas for a resource with name %s are not supported", resource)
+// AddResourceQuota does not check the resource type, quotas sent by clients
+// are validated by the ResourceTypeRegistry of the org first.
+func (n *Namespace) AddResourceQuota(resource string, quota int64) error {
+	if quota < 0 {
+		return fmt.Errorf("quota for the resource %s must not be negative", resource)
 	}
 	n.resourceQuotas[resource] = quota
 	return nil
@@ -75,23 +105,115 @@ func (n Namespace) GetAvailable() ResourceQuotas {
 
 func (n *Namespace) SetAvailable(available ResourceQuotas) error {
 	for resource := range available {
-		if !slices.Contains(SupportedResourceQuotas, resource) {
-			return fmt.Errorf("quotas for a resource with name %s are not supported", resource)
+		if _, found := n.resourceQuotas[resource]; !found {
+			return fmt.Errorf("no quota is set for the resource %s", resource)
 		}
 	}
 	maps.Copy(n.available, available)
 	return nil
 }
 
+// GetUtilized returns the sum of the quotas of the child namespaces and apps.
 func (n Namespace) GetUtilized() ResourceQuotas {
 	quotas := make(ResourceQuotas)
-	maps.Copy(quotas, n.resourceQuotas)
-	for resource, available := range n.available {
-		quotas[resource] = quotas[resource] - available
+	maps.Copy(quotas, n.utilized)
+	return quotas
+}
+
+func (n *Namespace) SetUtilized(utilized ResourceQuotas) {
+	maps.Copy(n.utilized, utilized)
+}
+
+// GetElasticMax returns how much of each resource the children of the namespace can have
+// by borrowing from the sibling namespaces.
+func (n Namespace) GetElasticMax() ResourceQuotas {
+	quotas := make(ResourceQuotas)
+	maps.Copy(quotas, n.elasticMax)
+	return quotas
+}
+
+func (n *Namespace) SetElasticMax(elasticMax ResourceQuotas) {
+	n.elasticMax = make(ResourceQuotas)
+	maps.Copy(n.elasticMax, elasticMax)
+}
+
+// GetBorrowed returns the capacity borrowed from the sibling namespaces,
+// which is reclaimable by them.
+func (n Namespace) GetBorrowed() ResourceQuotas {
+	quotas := make(ResourceQuotas)
+	for resource, effective := range n.GetEffective() {
+		if borrowed := n.utilized[resource] - effective; borrowed > 0 {
+			quotas[resource] = borrowed
+		}
+	}
+	return quotas
+}
+
+func (n Namespace) GetOvercommits() Overcommits {
+	return n.overcommits.clone()
+}
+
+func (n *Namespace) SetOvercommits(overcommits Overcommits) {
+	n.overcommits = overcommits.clone()
+}
+
+// GetLimitRange returns the bounds of the quotas of the apps in the namespace.
+func (n Namespace) GetLimitRange() LimitRange {
+	return n.limitRange.Clone()
+}
+
+func (n *Namespace) SetLimitRange(limitRange LimitRange) {
+	n.limitRange = limitRange.Clone()
+}
+
+func (n Namespace) GetCountQuotas() CountQuotas {
+	return n.countQuotas
+}
+
+func (n *Namespace) SetCountQuotas(countQuotas CountQuotas) {
+	n.countQuotas = countQuotas
+}
+
+func (n Namespace) GetUtilizationThresholds() UtilizationThresholds {
+	return n.thresholds.clone()
+}
+
+func (n *Namespace) SetUtilizationThresholds(thresholds UtilizationThresholds) {
+	n.thresholds = thresholds.clone()
+}
+
+// GetAlertLevels returns the alert levels of the resources with utilization thresholds.
+func (n Namespace) GetAlertLevels() map[string]AlertLevel {
+	effective := n.GetEffective()
+	levels := make(map[string]AlertLevel)
+	for resource := range n.thresholds {
+		levels[resource] = n.thresholds.Level(resource, n.utilized[resource], effective[resource])
+	}
+	return levels
+}
+
+// GetEffective returns the quotas multiplied by the overcommit factors,
+// which is how much of each resource the children of the namespace can have.
+func (n Namespace) GetEffective() ResourceQuotas {
+	quotas := make(ResourceQuotas)
+	for resource, quota := range n.resourceQuotas {
+		quotas[resource] = EffectiveQuota(quota, n.overcommits.Get(resource))
 	}
 	return quotas
 }
 
+// CheckFitsIn returns an error if the namespace quotas, which also cover the
+// quotas of its whole subtree, exceed the resources available in parent.
+func (n Namespace) CheckFitsIn(parent Namespace) error {
+	available := parent.GetAvailable()
+	for resource, quota := range n.resourceQuotas {
+		if available[resource] < quota {
+			return fmt.Errorf("requested %s quota for the resource %s, but only %s available in parent", FormatMilliUnits(quota), resource, FormatMilliUnits(available[resource]))
+		}
+	}
+	return nil
+}
+
 func (n Namespace) GetProfileVersion() string {
 	return n.profileVersion
 }
@@ -104,12 +226,20 @@ func (n Namespace) GetLabels() map[string]string {
 	return n.labels
 }
 
-func (n Namespace) GetLabelsJson() string {
-	labels, err := json.Marshal(n.labels)
+// PatchLabels adds or replaces the given labels and removes the labels with removed keys.
+func (n *Namespace) PatchLabels(labels map[string]string, removed []string) error {
+	err := ValidateLabels(labels)
 	if err != nil {
-		log.Println(err)
+		return err
+	}
+	patched := make(map[string]string)
+	maps.Copy(patched, n.labels)
+	maps.Copy(patched, labels)
+	for _, key := range removed {
+		delete(patched, key)
 	}
-	return string(labels)
+	n.labels = patched
+	return nil
 }
 
 func (n Namespace) GetSeccompProfile() SeccompProfile {
@@ -144,13 +274,63 @@ type NamespaceTreeNode struct {
 	Children  []*NamespaceTreeNode `json:"child_namespaces"`
 }
 
+func (n *NamespaceTreeNode) contains(id string) bool {
+	if n.Namespace != nil && n.Namespace.GetId() == id {
+		return true
+	}
+	for _, child := range n.Children {
+		if child.contains(id) {
+			return true
+		}
+	}
+	return false
+}
+
+// WalkBottomUp visits the child namespaces before their parent and stops at the first error.
+func (n *NamespaceTreeNode) WalkBottomUp(visit func(node *NamespaceTreeNode) error) error {
+	for _, child := range n.Children {
+		err := child.WalkBottomUp(visit)
+		if err != nil {
+			return err
+		}
+	}
+	return visit(n)
+}
+
 type NamespaceTree struct {
 	Root NamespaceTreeNode
 }
 
+// Contains reports whether the namespace with the given id is the root or one of its descendants.
+func (t NamespaceTree) Contains(id string) bool {
+	return t.Root.contains(id)
+}
+
+type NamespaceQuery struct {
+	OrgId    string
+	Selector LabelSelector
+	// After is the id of the last namespace of the previous page,
+	// namespaces are listed in the order of their ids.
+	After string
+	Limit int
+}
+
 type NamespaceStore interface {
-	Add(namespace Namespace, parent *Namespace) error
-	Get(id string) (Namespace, error)
-	GetHierarchy(rootId string) (NamespaceTree, error)
-	Remove(id string) error
+	Add(tx Tx, namespace Namespace, parent *Namespace) error
+	Get(tx Tx, id string) (Namespace, error)
+	GetHierarchy(tx Tx, rootId string) (NamespaceTree, error)
+	List(tx Tx, query NamespaceQuery) ([]Namespace, error)
+	// GetParent returns nil if the namespace is a top-level namespace of the org.
+	GetParent(tx Tx, id string) (*Namespace, error)
+	// Move makes the namespace a top-level namespace of the org if the parent is nil.
+	Move(tx Tx, id string, parent *Namespace) error
+	// SetLabels replaces all labels of the namespace.
+	SetLabels(tx Tx, id string, labels map[string]string) error
+	SetLimitRange(tx Tx, id string, limitRange LimitRange) error
+	SetCountQuotas(tx Tx, id string, countQuotas CountQuotas) error
+	// SetUtilizationThresholds replaces all utilization thresholds of the namespace.
+	SetUtilizationThresholds(tx Tx, id string, thresholds UtilizationThresholds) error
+	// GetCounts returns the number of apps and namespaces in the subtree of the namespace.
+	GetCounts(tx Tx, id string) (ObjectCounts, error)
+	Remove(tx Tx, id string) error
 }
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"math"
+)
+
+const (
+	// NoOvercommit is the overcommit factor of resources a namespace hands out only up to its quota.
+	// Factors are kept in thousandths, so that the effective capacity is computed with integers.
+	NoOvercommit int64 = 1000
+	// MaxOvercommit limits how many times its quota a namespace can hand out.
+	MaxOvercommit = 100 * NoOvercommit
+)
+
+// Overcommits holds the overcommit factors of a namespace per resource, in thousandths.
+// A factor of 2000 lets the children of a namespace have twice as much of the resource as the namespace itself.
+type Overcommits map[string]int64
+
+func (o Overcommits) Get(resource string) int64 {
+	if factor, found := o[resource]; found {
+		return factor
+	}
+	return NoOvercommit
+}
+
+// Factors returns the overcommit factors as ratios, such as 2.0 for twice the quota.
+func (o Overcommits) Factors() map[string]float64 {
+	factors := make(map[string]float64)
+	for resource, factor := range o {
+		factors[resource] = float64(factor) / float64(NoOvercommit)
+	}
+	return factors
+}
+
+// EffectiveQuota returns the quota the children of a namespace can have in total.
+func EffectiveQuota(quota, overcommit int64) int64 {
+	return quota * overcommit / NoOvercommit
+}
+
+// ParseOvercommits converts overcommit ratios such as 2.0 into overcommit factors.
+func (r *ResourceTypeRegistry) ParseOvercommits(orgId string, factors map[string]float64) (Overcommits, error) {
+	overcommits := make(Overcommits)
+	for resource, factor := range factors {
+		if _, found := r.Get(orgId, resource); !found {
+			return nil, fmt.Errorf("quotas for a resource with name %s are not supported", resource)
+		}
+		overcommit := int64(math.Round(factor * float64(NoOvercommit)))
+		if math.IsNaN(factor) || overcommit < NoOvercommit || overcommit > MaxOvercommit {
+			return nil, fmt.Errorf("overcommit factor for the resource %s must be between 1 and %d", resource, MaxOvercommit/NoOvercommit)
+		}
+		overcommits[resource] = overcommit
+	}
+	return overcommits, nil
+}
+
+func (o Overcommits) clone() Overcommits {
+	clone := make(Overcommits)
+	maps.Copy(clone, o)
+	return clone
+}
@@ -0,0 +1,238 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// quantityRegex splits a quantity such as 512Mi, 0.5 or 500m into the number and the suffix
+var quantityRegex = regexp.MustCompile(`^(-?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+))([A-Za-z]*)$`)
+
+// byteUnits holds the units of resource types that measure bytes, in bytes
+var byteUnits = map[string]*big.Rat{
+	"B":   rat("1"),
+	"kB":  rat("1e3"),
+	"MB":  rat("1e6"),
+	"GB":  rat("1e9"),
+	"TB":  rat("1e12"),
+	"KiB": rat("1024"),
+	"MiB": rat("1048576"),
+	"GiB": rat("1073741824"),
+	"TiB": rat("1099511627776"),
+}
+
+type quantitySuffix struct {
+	suffix     string
+	multiplier *big.Rat
+}
+
+// largest multipliers first, so that formatting picks the shortest representation
+var (
+	binarySuffixes = []quantitySuffix{
+		{"Ei", rat("1152921504606846976")},
+		{"Pi", rat("1125899906842624")},
+		{"Ti", rat("1099511627776")},
+		{"Gi", rat("1073741824")},
+		{"Mi", rat("1048576")},
+		{"Ki", rat("1024")},
+	}
+	decimalSuffixes = []quantitySuffix{
+		{"E", rat("1e18")}, {"P", rat("1e15")}, {"T", rat("1e12")}, {"G", rat("1e9")}, {"M", rat("1e6")}, {"k", rat("1e3")},
+	}
+	milliSuffix = quantitySuffix{"m", rat("1/1000")}
+)
+
+func rat(value string) *big.Rat {
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		panic(fmt.Sprintf("invalid rational number %s", value))
+	}
+	return r
+}
+
+// round returns the nearest whole number, halves are rounded away from zero.
+func round(value *big.Rat) *big.Int {
+	half := big.NewRat(int64(value.Sign()), 2)
+	shifted := new(big.Rat).Add(value, half)
+	return new(big.Int).Quo(shifted.Num(), shifted.Denom())
+}
+
+// measuresBytes tells if quantities of the resource type can use the binary suffixes.
+func (t ResourceType) measuresBytes() bool {
+	_, found := byteUnits[t.Unit]
+	return found
+}
+
+// suffixes returns the suffixes quantities of the resource type can use.
+// Bytes cannot be split into milli units.
+func (t ResourceType) suffixes() []quantitySuffix {
+	if t.measuresBytes() {
+		return append(append([]quantitySuffix{}, binarySuffixes...), decimalSuffixes...)
+	}
+	return append(append([]quantitySuffix{}, decimalSuffixes...), milliSuffix)
+}
+
+// milliUnitSize returns the size of a milli unit of the resource type in the base unit
+// the suffixes multiply, which is a byte for resources measured in bytes.
+func (t ResourceType) milliUnitSize() *big.Rat {
+	size := big.NewRat(1, MilliUnits)
+	if unitSize, found := byteUnits[t.Unit]; found {
+		size.Mul(size, unitSize)
+	}
+	return size
+}
+
+// toMilliUnits converts value times multiplier to milli units of the resource type, rounded to the nearest one.
+func (t ResourceType) toMilliUnits(value, multiplier *big.Rat) (int64, error) {
+	milli := new(big.Rat).Mul(value, multiplier)
+	rounded := round(milli.Quo(milli, t.milliUnitSize()))
+	if !rounded.IsInt64() {
+		return 0, fmt.Errorf("quota for the resource %s is too large", t.Name)
+	}
+	return rounded.Int64(), nil
+}
+
+// ParseQuantity converts a quantity such as 512Mi, 2Gi, 10G or 500m into a quota in
+// milli units of the resource type, rounded to the nearest milli unit.
+// A quantity without a suffix is in the unit of the resource type.
+func (t ResourceType) ParseQuantity(quantity string) (int64, error) {
+	match := quantityRegex.FindStringSubmatch(strings.TrimSpace(quantity))
+	if match == nil {
+		return 0, fmt.Errorf("invalid quantity %q for the resource %s", quantity, t.Name)
+	}
+	value, ok := new(big.Rat).SetString(match[1])
+	if !ok {
+		return 0, fmt.Errorf("invalid quantity %q for the resource %s", quantity, t.Name)
+	}
+	multiplier := new(big.Rat).Mul(t.milliUnitSize(), big.NewRat(MilliUnits, 1))
+	if suffix := match[2]; suffix != "" {
+		found := false
+		for _, s := range t.suffixes() {
+			if s.suffix == suffix {
+				multiplier, found = s.multiplier, true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unsupported suffix %s in quantity %q for the resource %s measured in %s", suffix, quantity, t.Name, t.Unit)
+		}
+	}
+	quota, err := t.toMilliUnits(value, multiplier)
+	if err != nil {
+		return 0, err
+	}
+	if err := t.ValidateQuota(quota); err != nil {
+		return 0, err
+	}
+	return quota, nil
+}
+
+// FormatQuantity converts a quota in milli units of the resource type into the shortest
+// quantity with a suffix that represents it exactly or, if there is none, parses back into
+// the same quota. Whole numbers of resources not measured in bytes and quotas with no such
+// quantity are formatted as plain numbers.
+func (t ResourceType) FormatQuantity(quota int64) string {
+	plain := FormatMilliUnits(quota)
+	if quota == 0 || (!t.measuresBytes() && quota%MilliUnits == 0) {
+		return plain
+	}
+	base := new(big.Rat).Mul(big.NewRat(quota, 1), t.milliUnitSize())
+	exact, rounded := "", ""
+	for _, s := range t.suffixes() {
+		value := new(big.Rat).Quo(base, s.multiplier)
+		if value.IsInt() {
+			if candidate := value.Num().String() + s.suffix; exact == "" || len(candidate) < len(exact) {
+				exact = candidate
+			}
+			continue
+		}
+		nearest := round(value)
+		if nearest.Sign() == 0 {
+			continue
+		}
+		if milli, err := t.toMilliUnits(new(big.Rat).SetInt(nearest), s.multiplier); err != nil || milli != quota {
+			continue
+		}
+		if candidate := nearest.String() + s.suffix; rounded == "" || len(candidate) < len(rounded) {
+			rounded = candidate
+		}
+	}
+	if exact != "" {
+		return exact
+	}
+	if rounded != "" {
+		return rounded
+	}
+	return plain
+}
+
+// ParseResourceQuantities converts the quantities of the resources into quotas.
+func (r *ResourceTypeRegistry) ParseResourceQuantities(orgId string, quantities map[string]string) (ResourceQuotas, error) {
+	quotas := make(ResourceQuotas)
+	for resource, quantity := range quantities {
+		resourceType, found := r.Get(orgId, resource)
+		if !found {
+			return nil, fmt.Errorf("quotas for a resource with name %s are not supported", resource)
+		}
+		quota, err := resourceType.ParseQuantity(quantity)
+		if err != nil {
+			return nil, err
+		}
+		quotas[resource] = quota
+	}
+	return quotas, nil
+}
+
+// FormatResourceQuotas converts the quotas into quantities, quotas of unknown resources
+// are kept as plain numbers.
+func (r *ResourceTypeRegistry) FormatResourceQuotas(orgId string, quotas ResourceQuotas) map[string]string {
+	quantities := make(map[string]string)
+	for resource, quota := range quotas {
+		quantities[resource] = r.FormatResourceQuantity(orgId, resource, quota)
+	}
+	return quantities
+}
+
+// ParseResourceUnits converts quotas in the units of the resource types, sent by clients
+// that do not use quantities yet, into quotas rounded to the nearest milli unit.
+func (r *ResourceTypeRegistry) ParseResourceUnits(orgId string, units map[string]float64) (ResourceQuotas, error) {
+	quotas := make(ResourceQuotas)
+	for resource, value := range units {
+		resourceType, found := r.Get(orgId, resource)
+		if !found {
+			return nil, fmt.Errorf("quotas for a resource with name %s are not supported", resource)
+		}
+		milli := math.Round(value * MilliUnits)
+		if math.IsNaN(milli) || milli >= math.MaxInt64 || milli < math.MinInt64 {
+			return nil, fmt.Errorf("invalid quota %v for the resource %s", value, resource)
+		}
+		quota := int64(milli)
+		if err := resourceType.ValidateQuota(quota); err != nil {
+			return nil, err
+		}
+		quotas[resource] = quota
+	}
+	return quotas, nil
+}
+
+// FormatResourceUnits converts the quotas into units of the resource types for clients
+// that do not use quantities yet.
+func FormatResourceUnits(quotas ResourceQuotas) map[string]float64 {
+	units := make(map[string]float64)
+	for resource, quota := range quotas {
+		units[resource] = float64(quota) / MilliUnits
+	}
+	return units
+}
+
+// FormatResourceQuantity converts the quota of a resource into a quantity.
+func (r *ResourceTypeRegistry) FormatResourceQuantity(orgId, resource string, quota int64) string {
+	resourceType, found := r.Get(orgId, resource)
+	if !found {
+		return FormatMilliUnits(quota)
+	}
+	return resourceType.FormatQuantity(quota)
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+var (
+	testCpu    = ResourceType{Name: "cpu", Unit: "cores", Divisible: true}
+	testMemory = ResourceType{Name: "mem", Unit: "MiB", Divisible: true}
+	testGpu    = ResourceType{Name: "gpu", Unit: "devices", Divisible: false}
+)
+
+func TestParseQuantity(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType ResourceType
+		quantity     string
+		want         int64
+		wantErr      bool
+	}{
+		{"whole units", testCpu, "2", 2000, false},
+		{"decimal units", testCpu, "0.5", 500, false},
+		{"milli suffix", testCpu, "500m", 500, false},
+		{"decimal suffix", testCpu, "2k", 2000000, false},
+		{"surrounding spaces", testCpu, " 3 ", 3000, false},
+		{"rounded up to milli units", testCpu, "1.2345", 1235, false},
+		{"rounded down to milli units", testCpu, "1.2344", 1234, false},
+		{"rounded to zero", testCpu, "0.0004", 0, false},
+		{"binary suffix of bytes", testMemory, "512Mi", 512000, false},
+		{"larger binary suffix of bytes", testMemory, "2Gi", 2048000, false},
+		{"decimal suffix of bytes rounded to milli units", testMemory, "1G", 953674, false},
+		{"bytes in the unit", testMemory, "1", 1000, false},
+		{"whole number of indivisible resource", testGpu, "2", 2000, false},
+		{"fraction of indivisible resource", testGpu, "1.5", 0, true},
+		{"binary suffix of other resource", testCpu, "1Gi", 0, true},
+		{"milli suffix of bytes", testMemory, "500m", 0, true},
+		{"unknown suffix", testCpu, "2x", 0, true},
+		{"negative", testCpu, "-1", 0, true},
+		{"not a number", testCpu, "abc", 0, true},
+		{"empty", testCpu, "", 0, true},
+		{"too large", testCpu, "10E", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.resourceType.ParseQuantity(tt.quantity)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseQuantity(%q) error = %v, wantErr %v", tt.quantity, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseQuantity(%q) = %d, want %d", tt.quantity, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatQuantity(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType ResourceType
+		quota        int64
+		want         string
+	}{
+		{"zero", testCpu, 0, "0"},
+		{"whole units", testCpu, 2000, "2"},
+		{"large whole units", testCpu, 2000000, "2000"},
+		{"milli units", testCpu, 500, "500m"},
+		{"fraction above one", testCpu, 1500, "1500m"},
+		{"binary suffix", testMemory, 512000, "512Mi"},
+		{"larger binary suffix", testMemory, 1024000, "1Gi"},
+		{"decimal suffix that parses back", testMemory, 953674, "1G"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.resourceType.FormatQuantity(tt.quota); got != tt.want {
+				t.Errorf("FormatQuantity(%d) = %q, want %q", tt.quota, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatQuantityParsesBack(t *testing.T) {
+	for _, resourceType := range []ResourceType{testCpu, testMemory} {
+		for _, quota := range []int64{1, 7, 999, 1000, 1001, 123456, 953674, 1048576, 5000000000} {
+			quantity := resourceType.FormatQuantity(quota)
+			got, err := resourceType.ParseQuantity(quantity)
+			if err != nil {
+				t.Errorf("%s quota %d formatted as %q does not parse: %v", resourceType.Name, quota, quantity, err)
+				continue
+			}
+			if got != quota {
+				t.Errorf("%s quota %d formatted as %q parses back to %d", resourceType.Name, quota, quantity, got)
+			}
+		}
+	}
+}
+
+// defaultRegistry holds the default resource types without a store to load them from.
+func defaultRegistry() *ResourceTypeRegistry {
+	registry := &ResourceTypeRegistry{types: make(map[string]ResourceType)}
+	registry.Set(DefaultResourceTypes...)
+	return registry
+}
+
+func TestFormatMilliUnits(t *testing.T) {
+	tests := []struct {
+		quota int64
+		want  string
+	}{
+		{0, "0"},
+		{2000, "2"},
+		{1500, "1.5"},
+		{1, "0.001"},
+		{1230, "1.23"},
+		{-1500, "-1.5"},
+	}
+	for _, tt := range tests {
+		if got := FormatMilliUnits(tt.quota); got != tt.want {
+			t.Errorf("FormatMilliUnits(%d) = %q, want %q", tt.quota, got, tt.want)
+		}
+	}
+}
+
+func TestParseResourceUnits(t *testing.T) {
+	tests := []struct {
+		name    string
+		units   map[string]float64
+		want    ResourceQuotas
+		wantErr bool
+	}{
+		{"units", map[string]float64{"cpu": 1.5, "mem": 512}, ResourceQuotas{"cpu": 1500, "mem": 512000}, false},
+		{"rounded to milli units", map[string]float64{"cpu": 0.0006}, ResourceQuotas{"cpu": 1}, false},
+		{"unknown resource", map[string]float64{"unknown": 1}, nil, true},
+		{"negative", map[string]float64{"cpu": -1}, nil, true},
+		{"not a number", map[string]float64{"cpu": math.NaN()}, nil, true},
+		{"infinite", map[string]float64{"cpu": math.Inf(1)}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := defaultRegistry().ParseResourceUnits("", tt.units)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseResourceUnits(%v) error = %v, wantErr %v", tt.units, err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseResourceUnits(%v) = %v, want %v", tt.units, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatResourceUnits(t *testing.T) {
+	got := FormatResourceUnits(ResourceQuotas{"cpu": 1500, "mem": 512000})
+	want := map[string]float64{"cpu": 1.5, "mem": 512}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FormatResourceUnits() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// QuotaSample is the state of a resource of a namespace or an app after a change.
+// Samples are only added, so the state at any point in time is the one of the last sample before it.
+type QuotaSample struct {
+	EntityId string
+	Resource string
+	// quota of the entity, zero after the entity is removed
+	Allocated int64
+	// capacity left for the children of a namespace, zero for apps
+	Available int64
+	// sum of the quotas of the children of a namespace, zero for apps
+	Utilized  int64
+	Timestamp time.Time
+}
+
+type HistoryResolution string
+
+const (
+	// HistoryRaw returns the samples as they were recorded.
+	HistoryRaw    HistoryResolution = "raw"
+	HistoryHourly HistoryResolution = "hourly"
+	HistoryDaily  HistoryResolution = "daily"
+)
+
+// maxHistoryPoints bounds the size of a downsampled series.
+const maxHistoryPoints = 10000
+
+func ParseHistoryResolution(resolution string) (HistoryResolution, error) {
+	switch HistoryResolution(resolution) {
+	case "", HistoryRaw:
+		return HistoryRaw, nil
+	case HistoryHourly, HistoryDaily:
+		return HistoryResolution(resolution), nil
+	default:
+		return "", fmt.Errorf("unknown resolution %s, expected raw, hourly or daily", resolution)
+	}
+}
+
+func (r HistoryResolution) step() time.Duration {
+	switch r {
+	case HistoryHourly:
+		return time.Hour
+	case HistoryDaily:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// Downsample turns the samples of a resource into one point per hour or day from the start of the
+// period containing from to the one containing to. Each point holds the state at the end of its period,
+// or at to for the last one. The latest sample before from, if any, has to be included, so that the
+// state at the start is known. Raw samples within the range are returned as they are.
+func (r HistoryResolution) Downsample(samples []QuotaSample, from, to time.Time) ([]QuotaSample, error) {
+	samples = slices.Clone(samples)
+	slices.SortStableFunc(samples, func(a, b QuotaSample) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	step := r.step()
+	if step == 0 {
+		return slices.DeleteFunc(samples, func(sample QuotaSample) bool {
+			return sample.Timestamp.Before(from) || sample.Timestamp.After(to)
+		}), nil
+	}
+	start := from.UTC().Truncate(step)
+	if points := to.Sub(start) / step; points >= maxHistoryPoints {
+		return nil, fmt.Errorf("%d %s points requested, at most %d are supported", points, r, maxHistoryPoints)
+	}
+	points := make([]QuotaSample, 0)
+	var last *QuotaSample
+	next := 0
+	for period := start; !period.After(to); period = period.Add(step) {
+		end := period.Add(step)
+		for next < len(samples) && samples[next].Timestamp.Before(end) && !samples[next].Timestamp.After(to) {
+			last = &samples[next]
+			next++
+		}
+		// the resource had no state before its first sample
+		if last == nil {
+			continue
+		}
+		point := *last
+		point.Timestamp = period
+		points = append(points, point)
+	}
+	return points, nil
+}
+
+type QuotaHistoryQuery struct {
+	EntityId string
+	// Resources limits the query to the samples of the resources, samples of all resources are listed if it is empty.
+	Resources []string
+	From      time.Time
+	To        time.Time
+}
+
+// Matches reports whether the sample is of the entity and of one of the resources of the query, regardless of its time.
+func (q QuotaHistoryQuery) Matches(sample QuotaSample) bool {
+	return sample.EntityId == q.EntityId && (len(q.Resources) == 0 || slices.Contains(q.Resources, sample.Resource))
+}
+
+// GroupByResource splits the samples into the series of their resources, in the order of the resource names.
+func GroupByResource(samples []QuotaSample) [][]QuotaSample {
+	byResource := make(map[string][]QuotaSample)
+	resources := make([]string, 0)
+	for _, sample := range samples {
+		if _, found := byResource[sample.Resource]; !found {
+			resources = append(resources, sample.Resource)
+		}
+		byResource[sample.Resource] = append(byResource[sample.Resource], sample)
+	}
+	slices.Sort(resources)
+	series := make([][]QuotaSample, 0, len(resources))
+	for _, resource := range resources {
+		series = append(series, byResource[resource])
+	}
+	return series
+}
+
+type QuotaHistoryStore interface {
+	Add(tx Tx, samples []QuotaSample) error
+	// List returns the samples of the entity within the range of the query and, for each resource,
+	// the latest sample before the range, in the order they were recorded.
+	List(tx Tx, query QuotaHistoryQuery) ([]QuotaSample, error)
+	// ListSubtree returns the samples of the namespaces in the subtree of the namespace within the range
+	// and, for each namespace and resource, the latest sample before the range, in the order they were recorded.
+	ListSubtree(tx Tx, namespaceId string, from, to time.Time) ([]QuotaSample, error)
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDownsample(t *testing.T) {
+	base := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	at := func(offset time.Duration) time.Time {
+		return base.Add(offset)
+	}
+	sample := func(allocated int64, offset time.Duration) QuotaSample {
+		return QuotaSample{EntityId: "org/ns", Resource: "cpu", Allocated: allocated, Timestamp: at(offset)}
+	}
+	before := sample(1, -time.Hour)
+	samples := []QuotaSample{
+		sample(2, time.Hour+10*time.Minute),
+		sample(3, time.Hour+50*time.Minute),
+		sample(4, 3*time.Hour+5*time.Minute),
+		// after the end of the hourly ranges
+		sample(5, 4*time.Hour+30*time.Minute),
+		sample(9, 10*time.Hour),
+	}
+	type point struct {
+		allocated int64
+		timestamp time.Time
+	}
+	tests := []struct {
+		name       string
+		resolution HistoryResolution
+		samples    []QuotaSample
+		from, to   time.Time
+		want       []point
+		wantErr    bool
+	}{
+		{
+			name:       "raw samples within the range",
+			resolution: HistoryRaw,
+			samples:    append([]QuotaSample{before}, samples...),
+			from:       at(30 * time.Minute),
+			to:         at(4*time.Hour + 15*time.Minute),
+			want:       []point{{2, at(time.Hour + 10*time.Minute)}, {3, at(time.Hour + 50*time.Minute)}, {4, at(3*time.Hour + 5*time.Minute)}},
+		},
+		{
+			name:       "hourly with the state before the range",
+			resolution: HistoryHourly,
+			samples:    append([]QuotaSample{before}, samples...),
+			from:       at(30 * time.Minute),
+			to:         at(4*time.Hour + 15*time.Minute),
+			want:       []point{{1, at(0)}, {3, at(time.Hour)}, {3, at(2 * time.Hour)}, {4, at(3 * time.Hour)}, {4, at(4 * time.Hour)}},
+		},
+		{
+			name:       "hourly without a state before the first sample",
+			resolution: HistoryHourly,
+			samples:    samples,
+			from:       at(30 * time.Minute),
+			to:         at(4*time.Hour + 15*time.Minute),
+			want:       []point{{3, at(time.Hour)}, {3, at(2 * time.Hour)}, {4, at(3 * time.Hour)}, {4, at(4 * time.Hour)}},
+		},
+		{
+			name:       "hourly from unordered samples",
+			resolution: HistoryHourly,
+			samples:    []QuotaSample{samples[2], samples[0], before, samples[1]},
+			from:       at(30 * time.Minute),
+			to:         at(4*time.Hour + 15*time.Minute),
+			want:       []point{{1, at(0)}, {3, at(time.Hour)}, {3, at(2 * time.Hour)}, {4, at(3 * time.Hour)}, {4, at(4 * time.Hour)}},
+		},
+		{
+			name:       "daily",
+			resolution: HistoryDaily,
+			samples:    append([]QuotaSample{before}, samples...),
+			from:       at(5 * time.Hour),
+			to:         at(49 * time.Hour),
+			want:       []point{{9, at(0)}, {9, at(24 * time.Hour)}, {9, at(48 * time.Hour)}},
+		},
+		{
+			name:       "no samples",
+			resolution: HistoryDaily,
+			from:       at(0),
+			to:         at(48 * time.Hour),
+			want:       []point{},
+		},
+		{
+			name:       "too many points",
+			resolution: HistoryHourly,
+			samples:    samples,
+			from:       at(0),
+			to:         at(500 * 24 * time.Hour),
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.resolution.Downsample(tt.samples, tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Downsample() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Downsample() returned %d points, want %d: %v", len(got), len(tt.want), got)
+			}
+			for i, want := range tt.want {
+				if got[i].Allocated != want.allocated || !got[i].Timestamp.Equal(want.timestamp) {
+					t.Errorf("point %d = %d at %v, want %d at %v", i, got[i].Allocated, got[i].Timestamp, want.allocated, want.timestamp)
+				}
+			}
+		})
+	}
+}
+
+func TestParseHistoryResolution(t *testing.T) {
+	tests := []struct {
+		resolution string
+		want       HistoryResolution
+		wantErr    bool
+	}{
+		{"", HistoryRaw, false},
+		{"raw", HistoryRaw, false},
+		{"hourly", HistoryHourly, false},
+		{"daily", HistoryDaily, false},
+		{"weekly", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseHistoryResolution(tt.resolution)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseHistoryResolution(%q) = %q, %v, want %q, wantErr %v", tt.resolution, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
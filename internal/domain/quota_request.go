@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"maps"
+	"time"
+)
+
+// newId returns a random id for objects that are not named by the users.
+func newId() string {
+	id := make([]byte, 16)
+	// crypto/rand never fails on supported platforms
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+type QuotaRequestStatus string
+
+const (
+	QuotaRequestPending  QuotaRequestStatus = "pending"
+	QuotaRequestApproved QuotaRequestStatus = "approved"
+	QuotaRequestRejected QuotaRequestStatus = "rejected"
+)
+
+// QuotaRequest asks the users who manage the quotas of the parent namespace to set the quotas of a namespace.
+// Requests are kept after they are reviewed, so that quota changes can be audited.
+type QuotaRequest struct {
+	id            string
+	orgId         string
+	namespaceName string
+	requester     string
+	justification string
+	quotas        ResourceQuotas
+	createdAt     time.Time
+	status        QuotaRequestStatus
+	reviewer      string
+	reviewComment string
+	reviewedAt    time.Time
+}
+
+func NewQuotaRequest(orgId, namespaceName, requester, justification string, quotas ResourceQuotas, createdAt time.Time) QuotaRequest {
+	return QuotaRequest{
+		id:            newId(),
+		orgId:         orgId,
+		namespaceName: namespaceName,
+		requester:     requester,
+		justification: justification,
+		quotas:        maps.Clone(quotas),
+		createdAt:     createdAt,
+		status:        QuotaRequestPending,
+	}
+}
+
+// RestoreQuotaRequest recreates a stored quota request.
+func RestoreQuotaRequest(id, orgId, namespaceName, requester, justification string, quotas ResourceQuotas, createdAt time.Time, status QuotaRequestStatus, reviewer, reviewComment string, reviewedAt time.Time) QuotaRequest {
+	return QuotaRequest{
+		id:            id,
+		orgId:         orgId,
+		namespaceName: namespaceName,
+		requester:     requester,
+		justification: justification,
+		quotas:        maps.Clone(quotas),
+		createdAt:     createdAt,
+		status:        status,
+		reviewer:      reviewer,
+		reviewComment: reviewComment,
+		reviewedAt:    reviewedAt,
+	}
+}
+
+func (r QuotaRequest) GetId() string {
+	return r.id
+}
+
+func (r QuotaRequest) GetOrgId() string {
+	return r.orgId
+}
+
+func (r QuotaRequest) GetNamespaceName() string {
+	return r.namespaceName
+}
+
+func (r QuotaRequest) GetNamespaceId() string {
+	return MakeNamespaceId(r.orgId, r.namespaceName)
+}
+
+func (r QuotaRequest) GetRequester() string {
+	return r.requester
+}
+
+func (r QuotaRequest) GetJustification() string {
+	return r.justification
+}
+
+// GetResourceQuotas returns the desired quotas of the namespace.
+func (r QuotaRequest) GetResourceQuotas() ResourceQuotas {
+	return maps.Clone(r.quotas)
+}
+
+func (r QuotaRequest) GetCreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r QuotaRequest) GetStatus() QuotaRequestStatus {
+	return r.status
+}
+
+func (r QuotaRequest) GetReviewer() string {
+	return r.reviewer
+}
+
+func (r QuotaRequest) GetReviewComment() string {
+	return r.reviewComment
+}
+
+// GetReviewedAt returns the zero time if the request is pending.
+func (r QuotaRequest) GetReviewedAt() time.Time {
+	return r.reviewedAt
+}
+
+func (r QuotaRequest) IsPending() bool {
+	return r.status == QuotaRequestPending
+}
+
+// Review records the decision on a pending request.
+func (r *QuotaRequest) Review(status QuotaRequestStatus, reviewer, comment string, reviewedAt time.Time) {
+	r.status = status
+	r.reviewer = reviewer
+	r.reviewComment = comment
+	r.reviewedAt = reviewedAt
+}
+
+type QuotaRequestQuery struct {
+	OrgId string
+	// NamespaceId limits the query to the requests of a single namespace, all requests of the org are listed if it is empty.
+	NamespaceId string
+	// Status limits the query to the requests with the status, requests with any status are listed if it is empty.
+	Status QuotaRequestStatus
+}
+
+type QuotaRequestStore interface {
+	Add(tx Tx, request QuotaRequest) error
+	Get(tx Tx, id string) (QuotaRequest, error)
+	// List returns the requests in the order they were created.
+	List(tx Tx, query QuotaRequestQuery) ([]QuotaRequest, error)
+	// SetReview stores the status and the review of the request.
+	SetReview(tx Tx, request QuotaRequest) error
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"time"
+)
+
+func MakeReservationId(orgId, namespaceName, reservationName string) string {
+	return fmt.Sprintf("%s/reservations/%s", MakeNamespaceId(orgId, namespaceName), reservationName)
+}
+
+// Reservation holds quotas in a namespace for apps that do not exist yet.
+// Reserved quotas count as utilized until the reservation is converted into
+// the quotas of an app or expires.
+type Reservation struct {
+	namespace      Namespace
+	name           string
+	resourceQuotas ResourceQuotas
+	expiresAt      time.Time
+}
+
+func NewReservation(namespace Namespace, name string, expiresAt time.Time) Reservation {
+	return Reservation{
+		namespace:      namespace,
+		name:           name,
+		resourceQuotas: make(ResourceQuotas),
+		expiresAt:      expiresAt,
+	}
+}
+
+func (r Reservation) GetNamespace() Namespace {
+	return r.namespace
+}
+
+func (r Reservation) GetName() string {
+	return r.name
+}
+
+func (r Reservation) GetId() string {
+	return MakeReservationId(r.namespace.orgId, r.namespace.name, r.name)
+}
+
+func (r Reservation) GetResourceQuotas() ResourceQuotas {
+	quotas := make(ResourceQuotas)
+	maps.Copy(quotas, r.resourceQuotas)
+	return quotas
+}
+
+// AddResourceQuota does not check the resource type, like the quotas of apps.
+func (r *Reservation) AddResourceQuota(resource string, quota int64) error {
+	if quota < 0 {
+		return fmt.Errorf("quota for the resource %s must not be negative", resource)
+	}
+	r.resourceQuotas[resource] = quota
+	return nil
+}
+
+func (r Reservation) GetExpiresAt() time.Time {
+	return r.expiresAt
+}
+
+func (r Reservation) IsExpired(now time.Time) bool {
+	return !now.Before(r.expiresAt)
+}
+
+type ReservationStore interface {
+	Add(tx Tx, reservation Reservation) error
+	Get(tx Tx, id string) (Reservation, error)
+	// FindChildren returns the reservations of the namespace in the order of their ids.
+	FindChildren(tx Tx, namespace Namespace) ([]Reservation, error)
+	// ListExpired returns the reservations of all orgs that expired by now.
+	ListExpired(tx Tx, now time.Time) ([]Reservation, error)
+	Remove(tx Tx, id string) error
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// ResourcePrice is what a quantity of a resource costs for every hour it is allocated,
+// such as 0.04 per cpu-hour or 0.005 per GiB-hour of mem. Prices are in the currency the org is billed in.
+type ResourcePrice struct {
+	OrgId    string
+	Resource string
+	// quantity of the resource the price is for, in milli units
+	Per int64
+	// decimal number, such as 0.04
+	PerHour string
+}
+
+// ParseResourcePrice creates the price of a resource type of the org, per is a quantity such as 1Gi, 1 if empty.
+func (r *ResourceTypeRegistry) ParseResourcePrice(orgId, resource, per, perHour string) (ResourcePrice, error) {
+	resourceType, found := r.Get(orgId, resource)
+	if !found {
+		return ResourcePrice{}, fmt.Errorf("prices for a resource with name %s are not supported", resource)
+	}
+	if per == "" {
+		per = "1"
+	}
+	quantity, err := resourceType.ParseQuantity(per)
+	if err != nil {
+		return ResourcePrice{}, err
+	}
+	if quantity <= 0 {
+		return ResourcePrice{}, fmt.Errorf("price of the resource %s must be for a positive quantity", resource)
+	}
+	price := ResourcePrice{
+		OrgId:    orgId,
+		Resource: resource,
+		Per:      quantity,
+		PerHour:  perHour,
+	}
+	rate, ok := price.rate()
+	if !ok || rate.Sign() < 0 {
+		return ResourcePrice{}, fmt.Errorf("price of the resource %s must be a non-negative decimal number", resource)
+	}
+	return price, nil
+}
+
+// rate returns the price of a milli unit of the resource for an hour.
+func (p ResourcePrice) rate() (*big.Rat, bool) {
+	perHour, ok := new(big.Rat).SetString(p.PerHour)
+	if !ok || p.Per <= 0 {
+		return nil, false
+	}
+	return perHour.Quo(perHour, new(big.Rat).SetInt64(p.Per)), true
+}
+
+// Cost returns the price of the milli unit hours of the resource.
+func (p ResourcePrice) Cost(milliUnitHours *big.Rat) *big.Rat {
+	rate, ok := p.rate()
+	if !ok {
+		return new(big.Rat)
+	}
+	return rate.Mul(rate, milliUnitHours)
+}
+
+type ResourcePriceStore interface {
+	// Put creates the price of the resource or replaces the one the org has.
+	Put(tx Tx, price ResourcePrice) error
+	// List returns the prices of the org in the order of the resource names.
+	List(tx Tx, orgId string) ([]ResourcePrice, error)
+	Remove(tx Tx, orgId, resource string) error
+}
@@ -1,15 +1,60 @@
 package domain
 
-import "github.com/neo4j/neo4j-go-driver/v4/neo4j"
-
-var (
-	SupportedResourceQuotas = []string{"mem", "cpu", "disk"}
+import (
+	"fmt"
+	"maps"
+	"math/big"
+	"strconv"
 )
 
-type ResourceQuotas map[string]float64
+// MilliUnits is the number of milli units in one unit of a resource type.
+const MilliUnits = 1000
+
+// ResourceQuotas holds quotas in milli units of the resource type units,
+// so that they are added and subtracted without rounding errors.
+type ResourceQuotas map[string]int64
+
+// FormatMilliUnits formats a quota in milli units as a decimal number of units.
+func FormatMilliUnits(quota int64) string {
+	if quota%MilliUnits == 0 {
+		return strconv.FormatInt(quota/MilliUnits, 10)
+	}
+	formatted := big.NewRat(quota, MilliUnits).FloatString(3)
+	for formatted[len(formatted)-1] == '0' {
+		formatted = formatted[:len(formatted)-1]
+	}
+	return formatted
+}
+
+// TransferQuotas returns both quotas after the amounts are moved from one to the other.
+// The quotas passed in are not modified.
+func TransferQuotas(from, to, amounts ResourceQuotas) (ResourceQuotas, ResourceQuotas, error) {
+	from, to = maps.Clone(from), maps.Clone(to)
+	if from == nil {
+		from = make(ResourceQuotas)
+	}
+	if to == nil {
+		to = make(ResourceQuotas)
+	}
+	for resource, amount := range amounts {
+		if amount <= 0 {
+			return nil, nil, fmt.Errorf("transferred amount of the resource %s must be positive", resource)
+		}
+		if from[resource] < amount {
+			return nil, nil, fmt.Errorf("cannot transfer %s of the resource %s, the quota is only %s", FormatMilliUnits(amount), resource, FormatMilliUnits(from[resource]))
+		}
+		from[resource] -= amount
+		to[resource] += amount
+	}
+	return from, to, nil
+}
 
 type ResourceQuotaStore interface {
-	// todo: remove tx from the interface
-	SetResourceQuotas(entityId string, quotas ResourceQuotas, tx neo4j.Transaction) error
-	GetAvailableResources(tx neo4j.Transaction, entityId string) (ResourceQuotas, error)
+	SetResourceQuotas(tx Tx, entityId string, quotas ResourceQuotas) error
+	GetAvailableResources(tx Tx, entityId string) (ResourceQuotas, error)
+	GetUtilizedResources(tx Tx, entityId string) (ResourceQuotas, error)
+	// SetOvercommits fails if the effective quotas would no longer cover the quotas of the children.
+	SetOvercommits(tx Tx, entityId string, overcommits Overcommits) error
+	// SetElasticMax fails if the children already have more than the elastic max allows.
+	SetElasticMax(tx Tx, entityId string, elasticMax ResourceQuotas) error
 }
@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var resourceTypeNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+var DefaultResourceTypes = []ResourceType{
+	{Name: "mem", Unit: "MiB", Description: "memory", Divisible: true},
+	{Name: "cpu", Unit: "cores", Description: "cpu time", Divisible: true},
+	{Name: "disk", Unit: "GiB", Description: "disk space", Divisible: true},
+}
+
+type ResourceType struct {
+	// OrgId is empty for resource types available to all orgs
+	OrgId       string
+	Name        string
+	Unit        string
+	Description string
+	// quotas of resources that are not divisible must be whole numbers
+	Divisible bool
+}
+
+func (t ResourceType) Validate() error {
+	if !resourceTypeNameRegex.MatchString(t.Name) {
+		return fmt.Errorf("invalid resource type name %s", t.Name)
+	}
+	if t.Unit == "" {
+		return fmt.Errorf("resource type %s has no unit", t.Name)
+	}
+	return nil
+}
+
+func (t ResourceType) ValidateQuota(quota int64) error {
+	if quota < 0 {
+		return fmt.Errorf("quota for the resource %s must not be negative", t.Name)
+	}
+	if !t.Divisible && quota%MilliUnits != 0 {
+		return fmt.Errorf("quota for the resource %s must be a whole number", t.Name)
+	}
+	return nil
+}
+
+// ResourceTypeRegistry holds the resource types quotas can be set for. It is loaded from
+// the store once on startup and updated by the handlers that put or remove resource types.
+// The types other replicas put or removed are picked up by Watch.
+type ResourceTypeRegistry struct {
+	mu    sync.RWMutex
+	types map[string]ResourceType
+	store ResourceTypeStore
+	// version of the stored resource types the registry was last loaded at
+	version int64
+}
+
+func NewResourceTypeRegistry(store ResourceTypeStore) *ResourceTypeRegistry {
+	if store == nil {
+		log.Fatalln("store is nil while initializing resource type registry")
+	}
+	return &ResourceTypeRegistry{
+		types: make(map[string]ResourceType),
+		store: store,
+	}
+}
+
+func resourceTypeKey(orgId, name string) string {
+	return fmt.Sprintf("%s/%s", orgId, name)
+}
+
+// Get returns the resource type of the org, or the one available to all orgs if the org has none with the given name.
+func (r *ResourceTypeRegistry) Get(orgId, name string) (ResourceType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if resourceType, found := r.types[resourceTypeKey(orgId, name)]; found {
+		return resourceType, true
+	}
+	resourceType, found := r.types[resourceTypeKey("", name)]
+	return resourceType, found
+}
+
+// List returns the resource types available to the org.
+func (r *ResourceTypeRegistry) List(orgId string) []ResourceType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	types := make([]ResourceType, 0)
+	for _, resourceType := range r.types {
+		if resourceType.OrgId == "" || resourceType.OrgId == orgId {
+			types = append(types, resourceType)
+		}
+	}
+	slices.SortFunc(types, func(a, b ResourceType) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return types
+}
+
+// Set adds resource types that were put in the store.
+func (r *ResourceTypeRegistry) Set(types ...ResourceType) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, resourceType := range types {
+		r.types[resourceTypeKey(resourceType.OrgId, resourceType.Name)] = resourceType
+	}
+}
+
+// Remove drops a resource type that was removed from the store.
+func (r *ResourceTypeRegistry) Remove(orgId, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.types, resourceTypeKey(orgId, name))
+}
+
+// Load replaces the registry contents with the stored resource types,
+// the default resource types are stored first if there are none.
+func (r *ResourceTypeRegistry) Load() error {
+	version, err := r.store.Version(nil)
+	if err != nil {
+		return err
+	}
+	types, err := r.store.List(nil)
+	if err != nil {
+		return err
+	}
+	if len(types) == 0 {
+		for _, resourceType := range DefaultResourceTypes {
+			err := r.store.Put(nil, resourceType)
+			if err != nil {
+				return err
+			}
+		}
+		types = DefaultResourceTypes
+	}
+	r.replace(types, version)
+	return nil
+}
+
+// Refresh reloads the registry if the stored resource types changed since it was last loaded.
+func (r *ResourceTypeRegistry) Refresh() error {
+	version, err := r.store.Version(nil)
+	if err != nil {
+		return err
+	}
+	r.mu.RLock()
+	current := r.version
+	r.mu.RUnlock()
+	if version == current {
+		return nil
+	}
+	types, err := r.store.List(nil)
+	if err != nil {
+		return err
+	}
+	r.replace(types, version)
+	return nil
+}
+
+// Watch refreshes the registry every interval until stop is closed.
+func (r *ResourceTypeRegistry) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			err := r.Refresh()
+			if err != nil {
+				log.Println(err)
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (r *ResourceTypeRegistry) replace(types []ResourceType, version int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.types = make(map[string]ResourceType)
+	for _, resourceType := range types {
+		r.types[resourceTypeKey(resourceType.OrgId, resourceType.Name)] = resourceType
+	}
+	r.version = version
+}
+
+func (r *ResourceTypeRegistry) ValidateResourceQuota(orgId, resource string, quota int64) error {
+	resourceType, found := r.Get(orgId, resource)
+	if !found {
+		return fmt.Errorf("quotas for a resource with name %s are not supported", resource)
+	}
+	return resourceType.ValidateQuota(quota)
+}
+
+type ResourceTypeStore interface {
+	Put(tx Tx, resourceType ResourceType) error
+	// List returns the resource types of all orgs.
+	List(tx Tx) ([]ResourceType, error)
+	// Version changes every time a resource type is put or removed.
+	Version(tx Tx) (int64, error)
+	// InUse reports whether a quota is set for the resource type, in any org if the type is available to all orgs.
+	InUse(tx Tx, orgId, name string) (bool, error)
+	Remove(tx Tx, orgId, name string) error
+}
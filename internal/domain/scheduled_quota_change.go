@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"maps"
+	"time"
+)
+
+type ScheduledQuotaChangeStatus string
+
+const (
+	// ScheduledQuotaChangeScheduled changes wait for their next run, recurring changes stay scheduled.
+	ScheduledQuotaChangeScheduled ScheduledQuotaChangeStatus = "scheduled"
+	ScheduledQuotaChangeDone      ScheduledQuotaChangeStatus = "done"
+	ScheduledQuotaChangeFailed    ScheduledQuotaChangeStatus = "failed"
+)
+
+// ScheduledQuotaChange sets the quotas of a namespace or an app at a point in time
+// or repeatedly, on a cron schedule. The outcome of the last run is kept, so that
+// the changes that could not be applied are reported.
+type ScheduledQuotaChange struct {
+	id            string
+	orgId         string
+	namespaceName string
+	// empty if the quotas of the namespace are changed
+	appName   string
+	quotas    ResourceQuotas
+	cron      string
+	nextRunAt time.Time
+	status    ScheduledQuotaChangeStatus
+	lastRunAt time.Time
+	lastError string
+	failures  int64
+}
+
+// NewScheduledQuotaChange creates a change that runs once at runAt if cron is empty.
+func NewScheduledQuotaChange(orgId, namespaceName, appName string, quotas ResourceQuotas, cron string, runAt time.Time) (ScheduledQuotaChange, error) {
+	if cron != "" {
+		schedule, err := ParseCron(cron)
+		if err != nil {
+			return ScheduledQuotaChange{}, err
+		}
+		cron = schedule.String()
+		runAt = schedule.Next(time.Now())
+	}
+	return ScheduledQuotaChange{
+		id:            newId(),
+		orgId:         orgId,
+		namespaceName: namespaceName,
+		appName:       appName,
+		quotas:        maps.Clone(quotas),
+		cron:          cron,
+		nextRunAt:     runAt,
+		status:        ScheduledQuotaChangeScheduled,
+	}, nil
+}
+
+// RestoreScheduledQuotaChange recreates a stored scheduled quota change.
+func RestoreScheduledQuotaChange(id, orgId, namespaceName, appName string, quotas ResourceQuotas, cron string, nextRunAt time.Time, status ScheduledQuotaChangeStatus, lastRunAt time.Time, lastError string, failures int64) ScheduledQuotaChange {
+	return ScheduledQuotaChange{
+		id:            id,
+		orgId:         orgId,
+		namespaceName: namespaceName,
+		appName:       appName,
+		quotas:        maps.Clone(quotas),
+		cron:          cron,
+		nextRunAt:     nextRunAt,
+		status:        status,
+		lastRunAt:     lastRunAt,
+		lastError:     lastError,
+		failures:      failures,
+	}
+}
+
+func (c ScheduledQuotaChange) GetId() string {
+	return c.id
+}
+
+func (c ScheduledQuotaChange) GetOrgId() string {
+	return c.orgId
+}
+
+func (c ScheduledQuotaChange) GetNamespaceName() string {
+	return c.namespaceName
+}
+
+func (c ScheduledQuotaChange) GetAppName() string {
+	return c.appName
+}
+
+// GetEntityId returns the id of the namespace or the app whose quotas are changed.
+func (c ScheduledQuotaChange) GetEntityId() string {
+	if c.appName != "" {
+		return MakeAppId(c.orgId, c.namespaceName, c.appName)
+	}
+	return MakeNamespaceId(c.orgId, c.namespaceName)
+}
+
+func (c ScheduledQuotaChange) GetResourceQuotas() ResourceQuotas {
+	return maps.Clone(c.quotas)
+}
+
+// GetCron returns an empty string for changes that run once.
+func (c ScheduledQuotaChange) GetCron() string {
+	return c.cron
+}
+
+func (c ScheduledQuotaChange) GetNextRunAt() time.Time {
+	return c.nextRunAt
+}
+
+func (c ScheduledQuotaChange) GetStatus() ScheduledQuotaChangeStatus {
+	return c.status
+}
+
+// GetLastRunAt returns the zero time if the change has not run yet.
+func (c ScheduledQuotaChange) GetLastRunAt() time.Time {
+	return c.lastRunAt
+}
+
+// GetLastError returns an empty string if the last run succeeded.
+func (c ScheduledQuotaChange) GetLastError() string {
+	return c.lastError
+}
+
+// GetFailures returns the number of runs that could not be applied.
+func (c ScheduledQuotaChange) GetFailures() int64 {
+	return c.failures
+}
+
+// RecordRun stores the outcome of a run and schedules the next one, changes that run once are finished.
+func (c *ScheduledQuotaChange) RecordRun(ranAt time.Time, err error) {
+	c.lastRunAt = ranAt
+	c.lastError = ""
+	if err != nil {
+		c.lastError = err.Error()
+		c.failures++
+	}
+	if c.cron != "" {
+		if schedule, parseErr := ParseCron(c.cron); parseErr == nil {
+			c.nextRunAt = schedule.Next(ranAt)
+			return
+		}
+	}
+	c.nextRunAt = time.Time{}
+	c.status = ScheduledQuotaChangeDone
+	if err != nil {
+		c.status = ScheduledQuotaChangeFailed
+	}
+}
+
+type ScheduledQuotaChangeStore interface {
+	Add(tx Tx, change ScheduledQuotaChange) error
+	Get(tx Tx, id string) (ScheduledQuotaChange, error)
+	// List returns the changes of the org in the order of their next runs, finished changes come last.
+	List(tx Tx, orgId string) ([]ScheduledQuotaChange, error)
+	// ListDue returns the scheduled changes of all orgs whose next run is not after now.
+	ListDue(tx Tx, now time.Time) ([]ScheduledQuotaChange, error)
+	// SetRun stores the outcome of the last run and the next run of the change.
+	SetRun(tx Tx, change ScheduledQuotaChange) error
+	Remove(tx Tx, id string) error
+}
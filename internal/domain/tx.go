@@ -0,0 +1,11 @@
+package domain
+
+// Tx is an opaque, backend specific transaction handle. Stores that receive
+// a nil Tx run the operation in a transaction of their own.
+type Tx any
+
+type TxManager interface {
+	// Atomic runs fn in a single transaction which is committed if fn
+	// returns nil and rolled back otherwise.
+	Atomic(fn func(tx Tx) error) error
+}
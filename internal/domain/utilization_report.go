@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+)
+
+const (
+	DefaultLowUtilization  = 20.0
+	DefaultHighUtilization = 90.0
+	DefaultTopConsumers    = 10
+)
+
+type ResourceUtilization struct {
+	Resource  string
+	Total     int64
+	Effective int64
+	Available int64
+	Utilized  int64
+}
+
+// AvailablePercent returns the available resources in percent of the effective quota.
+func (u ResourceUtilization) AvailablePercent() float64 {
+	return percentOf(u.Available, u.Effective)
+}
+
+// UtilizedPercent returns the utilized resources in percent of the effective quota.
+func (u ResourceUtilization) UtilizedPercent() float64 {
+	return percentOf(u.Utilized, u.Effective)
+}
+
+func percentOf(value, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(value) * 100 / float64(total)
+}
+
+type NamespaceUtilization struct {
+	Name string
+	// empty for the namespace the report is for
+	Parent string
+	// in the order of the resource names
+	Resources []ResourceUtilization
+	// resources utilized below the low utilization
+	OverProvisioned []string
+	// resources utilized at or above the high utilization
+	NearExhaustion []string
+}
+
+// Consumer is a namespace ranked by the quotas its apps hold.
+type Consumer struct {
+	Namespace string
+	Consumed  int64
+	// consumed resources in percent of the effective quota of the namespace the report is for
+	Share float64
+}
+
+type ResourceConsumers struct {
+	Resource string
+	// largest consumers first
+	Consumers []Consumer
+}
+
+type UtilizationReportOptions struct {
+	// percentages of the effective quotas
+	LowUtilization  float64
+	HighUtilization float64
+	// number of consumers ranked for each resource
+	Top int
+}
+
+// UtilizationReport aggregates the utilization of the namespaces of a subtree for capacity reviews.
+type UtilizationReport struct {
+	OrgId string
+	// parents before their children
+	Namespaces []NamespaceUtilization
+	// in the order of the resource names
+	TopConsumers []ResourceConsumers
+}
+
+func NewUtilizationReport(orgId string, tree NamespaceTree, options UtilizationReportOptions) UtilizationReport {
+	report := UtilizationReport{
+		OrgId:        orgId,
+		TopConsumers: make([]ResourceConsumers, 0),
+	}
+	consumers := make(map[string][]Consumer)
+	var visit func(node *NamespaceTreeNode, parent string)
+	visit = func(node *NamespaceTreeNode, parent string) {
+		report.Namespaces = append(report.Namespaces, namespaceUtilization(*node.Namespace, parent, options))
+		consumed := make(ResourceQuotas)
+		for _, app := range node.Apps {
+			for resource, quota := range app.GetResourceQuotas() {
+				consumed[resource] += quota
+			}
+		}
+		for resource, quota := range consumed {
+			if quota <= 0 {
+				continue
+			}
+			consumers[resource] = append(consumers[resource], Consumer{
+				Namespace: node.Namespace.GetName(),
+				Consumed:  quota,
+			})
+		}
+		for _, child := range node.Children {
+			visit(child, node.Namespace.GetName())
+		}
+	}
+	visit(&tree.Root, "")
+	effective := tree.Root.Namespace.GetEffective()
+	for resource, ranked := range consumers {
+		slices.SortStableFunc(ranked, func(a, b Consumer) int {
+			return cmp.Compare(b.Consumed, a.Consumed)
+		})
+		if len(ranked) > options.Top {
+			ranked = ranked[:options.Top]
+		}
+		for i := range ranked {
+			ranked[i].Share = percentOf(ranked[i].Consumed, effective[resource])
+		}
+		report.TopConsumers = append(report.TopConsumers, ResourceConsumers{Resource: resource, Consumers: ranked})
+	}
+	slices.SortFunc(report.TopConsumers, func(a, b ResourceConsumers) int {
+		return cmp.Compare(a.Resource, b.Resource)
+	})
+	return report
+}
+
+func namespaceUtilization(namespace Namespace, parent string, options UtilizationReportOptions) NamespaceUtilization {
+	utilization := NamespaceUtilization{
+		Name:   namespace.GetName(),
+		Parent: parent,
+	}
+	total := namespace.GetResourceQuotas()
+	effective := namespace.GetEffective()
+	available := namespace.GetAvailable()
+	utilized := namespace.GetUtilized()
+	resources := make([]string, 0)
+	for _, quotas := range []ResourceQuotas{total, available, utilized} {
+		for resource := range quotas {
+			if !slices.Contains(resources, resource) {
+				resources = append(resources, resource)
+			}
+		}
+	}
+	slices.Sort(resources)
+	for _, resource := range resources {
+		resourceUtilization := ResourceUtilization{
+			Resource:  resource,
+			Total:     total[resource],
+			Effective: effective[resource],
+			Available: available[resource],
+			Utilized:  utilized[resource],
+		}
+		utilization.Resources = append(utilization.Resources, resourceUtilization)
+		// resources without a quota cannot be over-provisioned or run out
+		if resourceUtilization.Effective <= 0 {
+			continue
+		}
+		percent := resourceUtilization.UtilizedPercent()
+		if percent < options.LowUtilization {
+			utilization.OverProvisioned = append(utilization.OverProvisioned, resource)
+		}
+		if percent >= options.HighUtilization {
+			utilization.NearExhaustion = append(utilization.NearExhaustion, resource)
+		}
+	}
+	return utilization
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+)
+
+type AlertLevel string
+
+const (
+	AlertLevelOk       AlertLevel = "ok"
+	AlertLevelWarning  AlertLevel = "warning"
+	AlertLevelCritical AlertLevel = "critical"
+)
+
+// UtilizationThreshold holds the utilization of a resource at which alerts are raised,
+// in per mille of the effective quota of the namespace. Zero means there is no such threshold.
+type UtilizationThreshold struct {
+	Warning  int64
+	Critical int64
+}
+
+type UtilizationThresholds map[string]UtilizationThreshold
+
+// ParseUtilizationThresholds converts thresholds in percent, e.g. 80 and 95, into per mille.
+func (r *ResourceTypeRegistry) ParseUtilizationThresholds(orgId string, warning, critical map[string]float64) (UtilizationThresholds, error) {
+	thresholds := make(UtilizationThresholds)
+	for resource, percentage := range warning {
+		threshold, err := r.parseUtilizationThreshold(orgId, resource, percentage)
+		if err != nil {
+			return nil, err
+		}
+		thresholds[resource] = UtilizationThreshold{Warning: threshold}
+	}
+	for resource, percentage := range critical {
+		threshold, err := r.parseUtilizationThreshold(orgId, resource, percentage)
+		if err != nil {
+			return nil, err
+		}
+		if warning := thresholds[resource].Warning; warning >= threshold {
+			return nil, fmt.Errorf("warning threshold of the resource %s must be below the critical threshold", resource)
+		}
+		thresholds[resource] = UtilizationThreshold{Warning: thresholds[resource].Warning, Critical: threshold}
+	}
+	return thresholds, nil
+}
+
+func (r *ResourceTypeRegistry) parseUtilizationThreshold(orgId, resource string, percentage float64) (int64, error) {
+	if _, found := r.Get(orgId, resource); !found {
+		return 0, fmt.Errorf("quotas for a resource with name %s are not supported", resource)
+	}
+	// elastic quotas let the utilization exceed the quota
+	if math.IsNaN(percentage) || percentage <= 0 || percentage > 1000 {
+		return 0, fmt.Errorf("utilization threshold of the resource %s must be above 0%% and at most 1000%%", resource)
+	}
+	return int64(math.Round(percentage * 10)), nil
+}
+
+func (t UtilizationThresholds) clone() UtilizationThresholds {
+	if t == nil {
+		return make(UtilizationThresholds)
+	}
+	return maps.Clone(t)
+}
+
+// Level returns the alert level of the utilization of the resource.
+func (t UtilizationThresholds) Level(resource string, utilized, effective int64) AlertLevel {
+	threshold, found := t[resource]
+	if !found {
+		return AlertLevelOk
+	}
+	// utilized / effective >= threshold / 1000, without dividing
+	reached := func(threshold int64) bool {
+		if threshold == 0 {
+			return false
+		}
+		left := new(big.Int).Mul(big.NewInt(utilized), big.NewInt(1000))
+		right := new(big.Int).Mul(big.NewInt(threshold), big.NewInt(effective))
+		return left.Cmp(right) >= 0 && utilized > 0
+	}
+	switch {
+	case reached(threshold.Critical):
+		return AlertLevelCritical
+	case reached(threshold.Warning):
+		return AlertLevelWarning
+	default:
+		return AlertLevelOk
+	}
+}
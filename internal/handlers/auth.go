@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/c12s/meridian/internal/domain"
+	oortapi "github.com/c12s/oort/pkg/api"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// tokenMetadataKey is the metadata key of the token that authenticates the caller,
+// the same metadata is forwarded to magnetar.
+const tokenMetadataKey = "authz-token"
+
+// manageQuotasPermission is granted in oort on an org or a namespace, users who have it can set
+// the quotas of the namespaces below and review their quota requests.
+const manageQuotasPermission = "namespace.quotas.manage"
+
+// Auth configures how the callers that change namespace quotas are authenticated.
+type Auth struct {
+	// TokenSecret verifies the HS256 signature of the caller tokens, the subject of a token is the user.
+	TokenSecret []byte
+	// OpenQuotaChanges lets anyone set namespace quotas directly, the way it was before quota requests.
+	// It is meant for deployments whose clients do not send tokens yet, reviews of quota requests
+	// are authorized either way.
+	OpenQuotaChanges bool
+}
+
+// authenticatedUser returns the user the token of the call was issued to.
+func (m *MeridianGrpcHandler) authenticatedUser(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(tokenMetadataKey)) == 0 {
+		return "", status.Error(codes.Unauthenticated, "token missing")
+	}
+	token, err := jwt.Parse(md.Get(tokenMetadataKey)[0], func(token *jwt.Token) (any, error) {
+		return m.auth.TokenSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, err.Error())
+	}
+	user, err := token.Claims.GetSubject()
+	if err != nil || user == "" {
+		return "", status.Error(codes.Unauthenticated, "token has no subject")
+	}
+	return user, nil
+}
+
+// authorizeQuotaManager returns the caller if oort grants them the permission to manage quotas
+// on the parent of the namespace, or on the org for top-level namespaces.
+func (m *MeridianGrpcHandler) authorizeQuotaManager(ctx context.Context, tx domain.Tx, namespaceId string) (string, error) {
+	user, err := m.authenticatedUser(ctx)
+	if err != nil {
+		return "", err
+	}
+	namespace, err := m.namespaces.Get(tx, namespaceId)
+	if err != nil {
+		return "", err
+	}
+	parent, err := m.namespaces.GetParent(tx, namespaceId)
+	if err != nil {
+		return "", err
+	}
+	resp, err := m.evaluator.Authorize(ctx, &oortapi.AuthorizationReq{
+		Subject: &oortapi.Resource{
+			Id:   user,
+			Kind: "user",
+		},
+		Object:         parentResource(namespace.GetOrgId(), parent),
+		PermissionName: manageQuotasPermission,
+	})
+	if err != nil {
+		return "", err
+	}
+	if !resp.Authorized {
+		return "", status.Error(codes.PermissionDenied, "only the users who manage the quotas of the parent namespace can change the namespace quotas")
+	}
+	return user, nil
+}
+
+// authorizeQuotaChange returns an error unless the caller can set the quotas of the namespace directly.
+func (m *MeridianGrpcHandler) authorizeQuotaChange(ctx context.Context, tx domain.Tx, namespaceId string) error {
+	if m.auth.OpenQuotaChanges {
+		return nil
+	}
+	_, err := m.authorizeQuotaManager(ctx, tx, namespaceId)
+	return err
+}
@@ -3,16 +3,21 @@ package handlers
 import (
 	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"log"
+	"maps"
 	"math"
+	"math/big"
 	"math/rand"
+	"slices"
 	"strings"
 	"time"
 
 	gravityapi "github.com/c12s/gravity/pkg/api"
 	magnetarapi "github.com/c12s/magnetar/pkg/api"
+	"github.com/c12s/magnetar/pkg/messaging"
 	"github.com/c12s/meridian/internal/domain"
+	"github.com/c12s/meridian/internal/workers"
 	"github.com/c12s/meridian/pkg/api"
 	oortapi "github.com/c12s/oort/pkg/api"
 	pulsar_api "github.com/c12s/pulsar/model/protobuf"
@@ -24,79 +29,127 @@ import (
 
 type MeridianGrpcHandler struct {
 	api.UnimplementedMeridianServer
-	namespaces    domain.NamespaceStore
-	apps          domain.AppStore
-	resources     domain.ResourceQuotaStore
-	pulsar        pulsar_api.SeccompServiceClient
-	administrator *oortapi.AdministrationAsyncClient
-	gravity       gravityapi.AgentQueueClient
-	magnetar      magnetarapi.MagnetarClient
+	namespaces        domain.NamespaceStore
+	apps              domain.AppStore
+	reservations      domain.ReservationStore
+	quotaRequests     domain.QuotaRequestStore
+	schedules         domain.ScheduledQuotaChangeStore
+	quotaHistory      domain.QuotaHistoryStore
+	prices            domain.ResourcePriceStore
+	resources         domain.ResourceQuotaStore
+	resourceTypeStore domain.ResourceTypeStore
+	resourceTypes     *domain.ResourceTypeRegistry
+	txManager         domain.TxManager
+	pulsar            pulsar_api.SeccompServiceClient
+	administrator     Administrator
+	gravity           gravityapi.AgentQueueClient
+	magnetar          magnetarapi.MagnetarClient
+	publisher         messaging.Publisher
+	evaluator         oortapi.OortEvaluatorClient
+	alerter           *workers.UtilizationAlerter
+	recorder          *workers.QuotaHistoryRecorder
+	auth              Auth
 }
 
-func NewMeridianGrpcHandler(namespaces domain.NamespaceStore, apps domain.AppStore, pulsar pulsar_api.SeccompServiceClient, resources domain.ResourceQuotaStore, administrator *oortapi.AdministrationAsyncClient, gravity gravityapi.AgentQueueClient, magnetar magnetarapi.MagnetarClient) api.MeridianServer {
+// Stores holds the stores the handler uses, all of them have to be backed by the TxManager.
+type Stores struct {
+	Namespaces    domain.NamespaceStore
+	Apps          domain.AppStore
+	Resources     domain.ResourceQuotaStore
+	ResourceTypes domain.ResourceTypeStore
+	Reservations  domain.ReservationStore
+	QuotaRequests domain.QuotaRequestStore
+	Schedules     domain.ScheduledQuotaChangeStore
+	QuotaHistory  domain.QuotaHistoryStore
+	Prices        domain.ResourcePriceStore
+	TxManager     domain.TxManager
+}
+
+// Administrator sends oort administration requests, *oortapi.AdministrationAsyncClient implements it.
+type Administrator interface {
+	SendRequest(req any, callback func(resp *oortapi.AdministrationAsyncResp)) error
+}
+
+// Clients holds the clients of the services the handler calls.
+type Clients struct {
+	Pulsar        pulsar_api.SeccompServiceClient
+	Administrator Administrator
+	Gravity       gravityapi.AgentQueueClient
+	Magnetar      magnetarapi.MagnetarClient
+	// Publisher sends the app config removals to the nodes
+	Publisher messaging.Publisher
+	// Evaluator authorizes the callers that change namespace quotas
+	Evaluator oortapi.OortEvaluatorClient
+}
+
+func NewMeridianGrpcHandler(stores Stores, clients Clients, observers workers.Observers, resourceTypes *domain.ResourceTypeRegistry, auth Auth) api.MeridianServer {
 	return MeridianGrpcHandler{
-		namespaces:    namespaces,
-		apps:          apps,
-		pulsar:        pulsar,
-		resources:     resources,
-		administrator: administrator,
-		gravity:       gravity,
-		magnetar:      magnetar,
+		namespaces:        stores.Namespaces,
+		apps:              stores.Apps,
+		reservations:      stores.Reservations,
+		quotaRequests:     stores.QuotaRequests,
+		schedules:         stores.Schedules,
+		quotaHistory:      stores.QuotaHistory,
+		prices:            stores.Prices,
+		resources:         stores.Resources,
+		resourceTypeStore: stores.ResourceTypes,
+		resourceTypes:     resourceTypes,
+		txManager:         stores.TxManager,
+		pulsar:            clients.Pulsar,
+		administrator:     clients.Administrator,
+		gravity:           clients.Gravity,
+		magnetar:          clients.Magnetar,
+		publisher:         clients.Publisher,
+		evaluator:         clients.Evaluator,
+		alerter:           observers.Alerter,
+		recorder:          observers.Recorder,
+		auth:              auth,
 	}
 }
 
 func (m MeridianGrpcHandler) AddNamespace(ctx context.Context, req *api.AddNamespaceReq) (*api.AddNamespaceResp, error) {
-	namespace, err := m.namespaces.Get(domain.MakeNamespaceId(req.OrgId, req.Name))
-	if err == nil {
-		err = status.Error(codes.AlreadyExists, "namespace already exists")
+	namespace, parent, err := m.newNamespace(nil, req)
+	if err != nil {
 		return nil, err
 	}
-	var parent *domain.Namespace
-	if req.ParentName != "" {
-		p, err := m.namespaces.Get(domain.MakeNamespaceId(req.OrgId, req.ParentName))
-		if err != nil {
-			log.Println(err)
-			err = status.Error(codes.NotFound, "parent namespace not found")
-			return nil, err
-		}
-		parent = &p
+	strategy, err := resolveSeccompStrategy(req.SeccompDefinitionStrategy, parent)
+	if err != nil {
+		return nil, err
 	}
-	namespace = domain.NewNamespace(req.OrgId, req.Name, req.Profile.Version, req.Labels)
-	for resource, quota := range req.Quotas {
-		err := namespace.AddResourceQuota(resource, quota)
+	if !req.DryRun {
+		err = m.sendSeccompProfile(ctx,
+			strategy,
+			namespace.GetSeccompProfile(),
+			req.Profile, parent)
 		if err != nil {
-			log.Println(err)
-			err = status.Error(codes.InvalidArgument, err.Error())
 			return nil, err
 		}
 	}
-	err = m.sendSeccompProfile(ctx,
-		req.SeccompDefinitionStrategy,
-		namespace.GetSeccompProfile(),
-		req.Profile, parent)
-	if err != nil {
-		return nil, err
-	}
-	err = m.namespaces.Add(namespace, parent)
+	var parentAvailable domain.ResourceQuotas
+	err = m.atomic(req.DryRun, func(tx domain.Tx) error {
+		err := m.addNamespace(tx, namespace, parent)
+		if err != nil || parent == nil {
+			return err
+		}
+		parentAvailable, err = m.resources.GetAvailableResources(tx, parent.GetId())
+		return err
+	})
 	if err != nil {
 		log.Println(err)
-		err = status.Error(codes.Internal, err.Error())
-		return nil, err
+		return nil, statusError(err)
 	}
-	var parentRes *oortapi.Resource
-	if parent == nil {
-		parentRes = &oortapi.Resource{
-			Id:   req.OrgId,
-			Kind: "org",
-		}
-	} else {
-		parentRes = &oortapi.Resource{
-			Id:   parent.GetId(),
-			Kind: "namespace",
-		}
+	resp := &api.AddNamespaceResp{
+		SeccompStrategy: strategy,
+		ParentAvailable: m.resourceTypes.FormatResourceQuotas(req.OrgId, parentAvailable),
+	}
+	if req.DryRun {
+		return resp, nil
+	}
+	if parent != nil {
+		m.alerter.Check(parent.GetId())
 	}
 	err2 := m.administrator.SendRequest(&oortapi.CreateInheritanceRelReq{
-		From: parentRes,
+		From: parentResource(req.OrgId, parent),
 		To: &oortapi.Resource{
 			Id:   namespace.GetId(),
 			Kind: "namespace",
@@ -107,57 +160,229 @@ func (m MeridianGrpcHandler) AddNamespace(ctx context.Context, req *api.AddNames
 	if err2 != nil {
 		log.Println(err2)
 	}
-	return &api.AddNamespaceResp{}, nil
+	return resp, nil
 }
 
 func (m MeridianGrpcHandler) RemoveNamespace(ctx context.Context, req *api.RemoveNamespaceReq) (*api.RemoveNamespaceResp, error) {
-	tree, err := m.namespaces.GetHierarchy(domain.MakeNamespaceId(req.OrgId, req.Name))
-	if err == nil && (len(tree.Root.Children) > 0 || len(tree.Root.Apps) > 0) {
-		err = status.Error(codes.InvalidArgument, "namespace must not have applications or child namespaces")
-		return nil, err
-	}
-	err = m.namespaces.Remove(domain.MakeNamespaceId(req.OrgId, req.Name))
+	id := domain.MakeNamespaceId(req.OrgId, req.Name)
+	var tree domain.NamespaceTree
+	var parent *domain.Namespace
+	err := m.atomic(req.DryRun, func(tx domain.Tx) error {
+		var err error
+		tree, parent, err = m.removedNamespaceTree(tx, id, req.Cascade)
+		if err != nil {
+			return err
+		}
+		return m.removeNamespaceTree(tx, tree, parent)
+	})
 	if err != nil {
 		log.Println(err)
-		err = status.Error(codes.Internal, err.Error())
-		return nil, err
+		return nil, statusError(err)
+	}
+	resp := &api.RemoveNamespaceResp{}
+	var removed []string
+	_ = tree.Root.WalkBottomUp(func(node *domain.NamespaceTreeNode) error {
+		for _, app := range node.Apps {
+			resp.Apps = append(resp.Apps, &api.RemoveNamespaceResp_App{
+				Namespace: node.Namespace.GetName(),
+				Name:      app.GetName(),
+			})
+		}
+		resp.Namespaces = append(resp.Namespaces, node.Namespace.GetName())
+		removed = append(removed, node.Namespace.GetId())
+		return nil
+	})
+	if !req.DryRun {
+		if parent != nil {
+			removed = append(removed, parent.GetId())
+		}
+		m.alerter.Check(removed...)
+		m.cleanUpNamespaceTree(ctx, req.OrgId, &tree.Root, parent)
 	}
-	return &api.RemoveNamespaceResp{}, nil
+	return resp, nil
 }
 
-func (m MeridianGrpcHandler) AddApp(ctx context.Context, req *api.AddAppReq) (*api.AddAppResp, error) {
-	namespace, err := m.namespaces.Get(domain.MakeNamespaceId(req.OrgId, req.Namespace))
+func (m MeridianGrpcHandler) MoveNamespace(ctx context.Context, req *api.MoveNamespaceReq) (*api.MoveNamespaceResp, error) {
+	id := domain.MakeNamespaceId(req.OrgId, req.Name)
+	var oldParent, parent *domain.Namespace
+	moved := false
+	err := m.txManager.Atomic(func(tx domain.Tx) error {
+		subtree, err := m.namespaces.GetHierarchy(tx, id)
+		if err != nil {
+			log.Println(err)
+			return status.Error(codes.NotFound, "namespace not found")
+		}
+		// without a parent name the namespace becomes a top-level namespace of the org
+		if req.ParentName != "" {
+			parentId := domain.MakeNamespaceId(req.OrgId, req.ParentName)
+			p, err := m.namespaces.Get(tx, parentId)
+			if err != nil {
+				log.Println(err)
+				return status.Error(codes.NotFound, "parent namespace not found")
+			}
+			if subtree.Contains(parentId) {
+				return status.Error(codes.InvalidArgument, "namespace cannot be moved into its own subtree")
+			}
+			parent = &p
+		}
+		oldParent, err = m.namespaces.GetParent(tx, id)
+		if err != nil {
+			return err
+		}
+		if oldParent == nil && parent == nil || oldParent != nil && parent != nil && oldParent.GetId() == parent.GetId() {
+			return nil
+		}
+		if parent != nil {
+			err = subtree.Root.Namespace.CheckFitsIn(*parent)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+		// ancestors the namespace already belongs to hold its subtree in their counts
+		newAncestors, err := m.ancestors(tx, parent)
+		if err != nil {
+			return err
+		}
+		oldAncestors, err := m.ancestors(tx, oldParent)
+		if err != nil {
+			return err
+		}
+		newAncestors = slices.DeleteFunc(newAncestors, func(ancestor domain.Namespace) bool {
+			return slices.ContainsFunc(oldAncestors, func(old domain.Namespace) bool {
+				return old.GetId() == ancestor.GetId()
+			})
+		})
+		counts, err := m.namespaces.GetCounts(tx, id)
+		if err != nil {
+			return err
+		}
+		err = m.checkCountQuotas(tx, newAncestors, counts.Add(domain.ObjectCounts{Namespaces: 1}))
+		if err != nil {
+			return err
+		}
+		moved = true
+		err = m.namespaces.Move(tx, id, parent)
+		if err != nil {
+			return err
+		}
+		ids := make([]string, 0, 2)
+		for _, changed := range []*domain.Namespace{oldParent, parent} {
+			if changed != nil {
+				ids = append(ids, changed.GetId())
+			}
+		}
+		return m.recorder.RecordNamespaces(tx, ids...)
+	})
 	if err != nil {
 		log.Println(err)
-		err = status.Error(codes.NotFound, "namespace not found")
-		return nil, err
+		return nil, statusError(err)
 	}
-	app := domain.NewApp(namespace, req.Name, req.Profile.Version)
-	for resource, quota := range req.Quotas {
-		err := app.AddResourceQuota(resource, quota)
+	if !moved {
+		return &api.MoveNamespaceResp{}, nil
+	}
+	if oldParent != nil {
+		m.alerter.Check(oldParent.GetId())
+	}
+	if parent != nil {
+		m.alerter.Check(parent.GetId())
+	}
+	namespaceRes := &oortapi.Resource{
+		Id:   id,
+		Kind: "namespace",
+	}
+	err = m.administrator.SendRequest(&oortapi.DeleteInheritanceRelReq{
+		From: parentResource(req.OrgId, oldParent),
+		To:   namespaceRes,
+	}, func(resp *oortapi.AdministrationAsyncResp) {
+		log.Println(resp.Error)
+	})
+	if err != nil {
+		log.Println(err)
+	}
+	err = m.administrator.SendRequest(&oortapi.CreateInheritanceRelReq{
+		From: parentResource(req.OrgId, parent),
+		To:   namespaceRes,
+	}, func(resp *oortapi.AdministrationAsyncResp) {
+		log.Println(resp.Error)
+	})
+	if err != nil {
+		log.Println(err)
+	}
+	return &api.MoveNamespaceResp{}, nil
+}
+
+func (m MeridianGrpcHandler) UpdateNamespace(ctx context.Context, req *api.UpdateNamespaceReq) (*api.UpdateNamespaceResp, error) {
+	id := domain.MakeNamespaceId(req.OrgId, req.Name)
+	var labels map[string]string
+	err := m.txManager.Atomic(func(tx domain.Tx) error {
+		namespace, err := m.namespaces.Get(tx, id)
 		if err != nil {
 			log.Println(err)
-			err = status.Error(codes.InvalidArgument, err.Error())
-			return nil, err
+			return status.Error(codes.NotFound, "namespace not found")
+		}
+		err = namespace.PatchLabels(req.Labels, req.RemoveLabels)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
 		}
+		labels = namespace.GetLabels()
+		return m.namespaces.SetLabels(tx, id, labels)
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
 	}
-	err = m.sendSeccompProfile(ctx,
-		req.SeccompDefinitionStrategy,
-		app.GetSeccompProfile(),
-		req.Profile, &namespace)
+	return &api.UpdateNamespaceResp{Labels: labels}, nil
+}
+
+func (m MeridianGrpcHandler) AddApp(ctx context.Context, req *api.AddAppReq) (*api.AddAppResp, error) {
+	app, namespace, reservationId, err := m.newApp(nil, req)
 	if err != nil {
 		return nil, err
 	}
-	err = m.apps.Add(app)
+	strategy, err := resolveSeccompStrategy(req.SeccompDefinitionStrategy, &namespace)
 	if err != nil {
-		log.Println(err)
-		err = status.Error(codes.Internal, err.Error())
 		return nil, err
 	}
+	// nodes are selected before the app is added, so that an app that cannot be placed is not added
 	nodes, err := m.placeByGossip(context.Background(), req.OrgId, 50)
 	if err != nil {
 		return nil, err
 	}
+	if !req.DryRun {
+		err = m.sendSeccompProfile(ctx,
+			strategy,
+			app.GetSeccompProfile(),
+			req.Profile, &namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var available domain.ResourceQuotas
+	err = m.atomic(req.DryRun, func(tx domain.Tx) error {
+		err := m.addApp(tx, app, namespace, reservationId)
+		if err != nil {
+			return err
+		}
+		available, err = m.resources.GetAvailableResources(tx, namespace.GetId())
+		return err
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	resp := &api.AddAppResp{
+		SeccompStrategy:    strategy,
+		Nodes:              make([]string, 0),
+		NamespaceAvailable: m.resourceTypes.FormatResourceQuotas(req.OrgId, available),
+	}
+	if req.DryRun {
+		// nodes are selected at random, so the app may be placed on other ones
+		for _, node := range nodes {
+			resp.Nodes = append(resp.Nodes, node.Id)
+		}
+		return resp, nil
+	}
+	m.alerter.Check(namespace.GetId())
 	profile, err := json.MarshalIndent(m.getSeccompProfile(ctx, app.GetSeccompProfile()), "", "\t")
 	if err != nil {
 		return nil, err
@@ -167,118 +392,1259 @@ func (m MeridianGrpcHandler) AddApp(ctx context.Context, req *api.AddAppReq) (*a
 		NamespaceName:  req.Namespace,
 		AppName:        req.Name,
 		SeccompProfile: string(profile),
-		Quotas:         req.Quotas,
+		Quotas:         domain.FormatResourceUnits(app.GetResourceQuotas()),
+		QuotasMilli:    app.GetResourceQuotas(),
 	}
 	cmdMarshalled, err := proto.Marshal(&cmd)
 	if err != nil {
 		return nil, err
 	}
+	disseminated := make([]string, 0)
 	for _, node := range nodes {
 		_, err = m.gravity.DisseminateAppConfig(context.Background(), &gravityapi.DeseminateConfigRequest{
 			NodeId: node.Id,
 			Config: cmdMarshalled,
 		})
 		if err != nil {
-			log.Println(err)
-			err = status.Error(codes.Internal, err.Error())
-			return nil, err
+			break
 		}
+		disseminated = append(disseminated, node.Id)
+	}
+	if err2 := m.apps.SetNodes(nil, app.GetId(), disseminated); err2 != nil {
+		log.Println(err2)
+	}
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
-	return &api.AddAppResp{}, nil
+	resp.Nodes = disseminated
+	return resp, nil
 }
 
 func (m MeridianGrpcHandler) RemoveApp(ctx context.Context, req *api.RemoveAppReq) (*api.RemoveAppResp, error) {
-	err := m.apps.Remove(domain.MakeAppId(req.OrgId, req.Namespace, req.Name))
+	id := domain.MakeAppId(req.OrgId, req.Namespace, req.Name)
+	var app domain.App
+	var available domain.ResourceQuotas
+	err := m.atomic(req.DryRun, func(tx domain.Tx) error {
+		var err error
+		app, err = m.removeApp(tx, id)
+		if err != nil {
+			return err
+		}
+		available, err = m.resources.GetAvailableResources(tx, app.GetNamespace().GetId())
+		return err
+	})
 	if err != nil {
 		log.Println(err)
-		err = status.Error(codes.Internal, err.Error())
-		return nil, err
+		return nil, statusError(err)
 	}
-	return &api.RemoveAppResp{}, nil
+	if !req.DryRun {
+		m.alerter.Check(app.GetNamespace().GetId())
+		m.cleanUpApp(ctx, app)
+	}
+	return &api.RemoveAppResp{
+		NamespaceAvailable:           domain.FormatResourceUnits(available),
+		NamespaceAvailableQuantities: m.resourceTypes.FormatResourceQuotas(req.OrgId, available),
+	}, nil
 }
 
-func (m MeridianGrpcHandler) GetNamespace(ctx context.Context, req *api.GetNamespaceReq) (*api.GetNamespaceResp, error) {
-	namespace, err := m.namespaces.Get(domain.MakeNamespaceId(req.OrgId, req.Name))
+func (m MeridianGrpcHandler) ReserveQuota(ctx context.Context, req *api.ReserveQuotaReq) (*api.ReserveQuotaResp, error) {
+	if req.TtlSeconds <= 0 {
+		err := status.Error(codes.InvalidArgument, "ttl must be positive")
+		return nil, err
+	}
+	namespace, err := m.namespaces.Get(nil, domain.MakeNamespaceId(req.OrgId, req.Namespace))
 	if err != nil {
 		log.Println(err)
 		err = status.Error(codes.NotFound, "namespace not found")
 		return nil, err
 	}
-	return &api.GetNamespaceResp{
-		Name:      namespace.GetName(),
-		Labels:    namespace.GetLabels(),
-		Total:     namespace.GetResourceQuotas(),
-		Available: namespace.GetAvailable(),
-		Utilized:  namespace.GetUtilized(),
-		Profile:   m.getSeccompProfile(ctx, namespace.GetSeccompProfile()),
+	quotas, err := m.resourceTypes.ParseResourceQuantities(req.OrgId, req.Quotas)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	reservation := domain.NewReservation(namespace, req.Name, time.Now().Add(time.Duration(req.TtlSeconds)*time.Second))
+	for resource, quota := range quotas {
+		err := reservation.AddResourceQuota(resource, quota)
+		if err != nil {
+			log.Println(err)
+			err = status.Error(codes.InvalidArgument, err.Error())
+			return nil, err
+		}
+	}
+	err = m.txManager.Atomic(func(tx domain.Tx) error {
+		if _, err := m.reservations.Get(tx, reservation.GetId()); err == nil {
+			return status.Error(codes.AlreadyExists, "reservation already exists")
+		}
+		err := m.reservations.Add(tx, reservation)
+		if err != nil {
+			return err
+		}
+		return m.recorder.RecordNamespaces(tx, namespace.GetId())
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	m.alerter.Check(namespace.GetId())
+	return &api.ReserveQuotaResp{
+		ExpiresAt: reservation.GetExpiresAt().Unix(),
 	}, nil
 }
 
-func (m MeridianGrpcHandler) GetNamespaceHierarchy(ctx context.Context, req *api.GetNamespaceHierarchyReq) (*api.GetNamespaceHierarchyResp, error) {
-	tree, err := m.namespaces.GetHierarchy(domain.MakeNamespaceId(req.OrgId, "default"))
+func (m MeridianGrpcHandler) ListReservations(ctx context.Context, req *api.ListReservationsReq) (*api.ListReservationsResp, error) {
+	namespace, err := m.namespaces.Get(nil, domain.MakeNamespaceId(req.OrgId, req.Namespace))
 	if err != nil {
 		log.Println(err)
-		err = status.Error(codes.NotFound, "namespace hierarchy not found")
+		err = status.Error(codes.NotFound, "namespace not found")
 		return nil, err
 	}
-	return m.mapNamespaceTreeNode(ctx, &tree.Root), nil
+	reservations, err := m.reservations.FindChildren(nil, namespace)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	resp := &api.ListReservationsResp{
+		Reservations: make([]*api.Reservation, 0, len(reservations)),
+	}
+	for _, reservation := range reservations {
+		resp.Reservations = append(resp.Reservations, &api.Reservation{
+			Namespace: req.Namespace,
+			Name:      reservation.GetName(),
+			Quotas:    m.resourceTypes.FormatResourceQuotas(req.OrgId, reservation.GetResourceQuotas()),
+			ExpiresAt: reservation.GetExpiresAt().Unix(),
+		})
+	}
+	return resp, nil
 }
 
-func (m MeridianGrpcHandler) SetNamespaceResources(ctx context.Context, req *api.SetNamespaceResourcesReq) (*api.SetNamespaceResourcesResp, error) {
-	err := m.resources.SetResourceQuotas(domain.MakeNamespaceId(req.OrgId, req.Name), domain.ResourceQuotas(req.Quotas), nil)
+func (m MeridianGrpcHandler) CancelReservation(ctx context.Context, req *api.CancelReservationReq) (*api.CancelReservationResp, error) {
+	id := domain.MakeReservationId(req.OrgId, req.Namespace, req.Name)
+	err := m.txManager.Atomic(func(tx domain.Tx) error {
+		if _, err := m.reservations.Get(tx, id); err != nil {
+			log.Println(err)
+			return status.Error(codes.NotFound, "reservation not found")
+		}
+		err := m.reservations.Remove(tx, id)
+		if err != nil {
+			return err
+		}
+		return m.recorder.RecordNamespaces(tx, domain.MakeNamespaceId(req.OrgId, req.Namespace))
+	})
 	if err != nil {
 		log.Println(err)
-		err = status.Error(codes.Internal, err.Error())
+		return nil, statusError(err)
+	}
+	m.alerter.Check(domain.MakeNamespaceId(req.OrgId, req.Namespace))
+	return &api.CancelReservationResp{}, nil
+}
+
+func (m MeridianGrpcHandler) GetApp(ctx context.Context, req *api.GetAppReq) (*api.GetAppResp, error) {
+	app, err := m.apps.Get(nil, domain.MakeAppId(req.OrgId, req.Namespace, req.Name))
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.NotFound, "app not found")
 		return nil, err
 	}
-	return &api.SetNamespaceResourcesResp{}, nil
+	return &api.GetAppResp{
+		Namespace:       app.GetNamespace().GetName(),
+		Name:            app.GetName(),
+		Total:           domain.FormatResourceUnits(app.GetResourceQuotas()),
+		TotalQuantities: m.resourceTypes.FormatResourceQuotas(req.OrgId, app.GetResourceQuotas()),
+		Profile:         m.getSeccompProfile(ctx, app.GetSeccompProfile()),
+		Nodes:           app.GetNodes(),
+	}, nil
 }
 
-func (m MeridianGrpcHandler) SetAppResources(ctx context.Context, req *api.SetAppResourcesReq) (*api.SetAppResourcesResp, error) {
-	err := m.resources.SetResourceQuotas(domain.MakeAppId(req.OrgId, req.Namespace, req.Name), domain.ResourceQuotas(req.Quotas), nil)
+func (m MeridianGrpcHandler) ListApps(ctx context.Context, req *api.ListAppsReq) (*api.ListAppsResp, error) {
+	after, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	limit := pageSize(req.PageSize)
+	// one app more than requested tells if there is a next page
+	query := domain.AppQuery{
+		OrgId: req.OrgId,
+		After: after,
+		Limit: limit + 1,
+	}
+	if req.Namespace != "" {
+		query.NamespaceId = domain.MakeNamespaceId(req.OrgId, req.Namespace)
+	}
+	apps, err := m.apps.List(nil, query)
 	if err != nil {
 		log.Println(err)
 		err = status.Error(codes.Internal, err.Error())
 		return nil, err
 	}
-	return &api.SetAppResourcesResp{}, nil
+	resp := &api.ListAppsResp{}
+	if len(apps) > limit {
+		apps = apps[:limit]
+		resp.NextPageToken = encodePageToken(apps[limit-1].GetId())
+	}
+	for _, app := range apps {
+		resp.Apps = append(resp.Apps, &api.ListAppsResp_App{
+			Namespace:       app.GetNamespace().GetName(),
+			Name:            app.GetName(),
+			Total:           domain.FormatResourceUnits(app.GetResourceQuotas()),
+			TotalQuantities: m.resourceTypes.FormatResourceQuotas(req.OrgId, app.GetResourceQuotas()),
+			Nodes:           app.GetNodes(),
+		})
+	}
+	return resp, nil
 }
 
-func (m *MeridianGrpcHandler) mapNamespaceTreeNode(ctx context.Context, node *domain.NamespaceTreeNode) *api.GetNamespaceHierarchyResp {
-	resp := &api.GetNamespaceHierarchyResp{
-		Namespace: &api.GetNamespaceHierarchyResp_Namespace{
-			Name:      node.Namespace.GetName(),
-			Labels:    node.Namespace.GetLabels(),
-			Total:     node.Namespace.GetResourceQuotas(),
-			Available: node.Namespace.GetAvailable(),
-			Utilized:  node.Namespace.GetUtilized(),
-			Profile:   m.getSeccompProfile(ctx, node.Namespace.GetSeccompProfile()),
+func (m MeridianGrpcHandler) GetNamespace(ctx context.Context, req *api.GetNamespaceReq) (*api.GetNamespaceResp, error) {
+	namespace, err := m.namespaces.Get(nil, domain.MakeNamespaceId(req.OrgId, req.Name))
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.NotFound, "namespace not found")
+		return nil, err
+	}
+	counts, err := m.namespaces.GetCounts(nil, namespace.GetId())
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	countQuotas := namespace.GetCountQuotas()
+	return &api.GetNamespaceResp{
+		Name:                namespace.GetName(),
+		Labels:              namespace.GetLabels(),
+		Total:               domain.FormatResourceUnits(namespace.GetResourceQuotas()),
+		TotalQuantities:     m.resourceTypes.FormatResourceQuotas(req.OrgId, namespace.GetResourceQuotas()),
+		Available:           domain.FormatResourceUnits(namespace.GetAvailable()),
+		AvailableQuantities: m.resourceTypes.FormatResourceQuotas(req.OrgId, namespace.GetAvailable()),
+		Utilized:            domain.FormatResourceUnits(namespace.GetUtilized()),
+		UtilizedQuantities:  m.resourceTypes.FormatResourceQuotas(req.OrgId, namespace.GetUtilized()),
+		Profile:             m.getSeccompProfile(ctx, namespace.GetSeccompProfile()),
+		Overcommit:          namespace.GetOvercommits().Factors(),
+		Effective:           m.resourceTypes.FormatResourceQuotas(req.OrgId, namespace.GetEffective()),
+		LimitRange:          m.mapLimitRange(req.OrgId, namespace.GetLimitRange()),
+		Counts: &api.ObjectCounts{
+			Apps:       counts.Apps,
+			Namespaces: counts.Namespaces,
 		},
+		CountQuotas: &api.ObjectCounts{
+			Apps:       countQuotas.MaxApps,
+			Namespaces: countQuotas.MaxNamespaces,
+		},
+		ElasticMax:  m.resourceTypes.FormatResourceQuotas(req.OrgId, namespace.GetElasticMax()),
+		Borrowed:    m.resourceTypes.FormatResourceQuotas(req.OrgId, namespace.GetBorrowed()),
+		Thresholds:  mapUtilizationThresholds(namespace.GetUtilizationThresholds()),
+		AlertLevels: mapAlertLevels(namespace.GetAlertLevels()),
+	}, nil
+}
+
+func (m MeridianGrpcHandler) ListNamespaces(ctx context.Context, req *api.ListNamespacesReq) (*api.ListNamespacesResp, error) {
+	selector, err := domain.ParseLabelSelector(req.LabelSelector)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
-	for _, app := range node.Apps {
-		resp.Apps = append(resp.Apps, &api.GetNamespaceHierarchyResp_App{
-			Name:    app.GetName(),
-			Total:   app.GetResourceQuotas(),
-			Profile: m.getSeccompProfile(ctx, app.GetSeccompProfile()),
+	after, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	limit := pageSize(req.PageSize)
+	// one namespace more than requested tells if there is a next page
+	namespaces, err := m.namespaces.List(nil, domain.NamespaceQuery{
+		OrgId:    req.OrgId,
+		Selector: selector,
+		After:    after,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	resp := &api.ListNamespacesResp{}
+	if len(namespaces) > limit {
+		namespaces = namespaces[:limit]
+		resp.NextPageToken = encodePageToken(namespaces[limit-1].GetId())
+	}
+	for _, namespace := range namespaces {
+		resp.Namespaces = append(resp.Namespaces, &api.ListNamespacesResp_Namespace{
+			Name:                namespace.GetName(),
+			Labels:              namespace.GetLabels(),
+			Total:               domain.FormatResourceUnits(namespace.GetResourceQuotas()),
+			TotalQuantities:     m.resourceTypes.FormatResourceQuotas(req.OrgId, namespace.GetResourceQuotas()),
+			Available:           domain.FormatResourceUnits(namespace.GetAvailable()),
+			AvailableQuantities: m.resourceTypes.FormatResourceQuotas(req.OrgId, namespace.GetAvailable()),
+			Utilized:            domain.FormatResourceUnits(namespace.GetUtilized()),
+			UtilizedQuantities:  m.resourceTypes.FormatResourceQuotas(req.OrgId, namespace.GetUtilized()),
 		})
 	}
-	for _, child := range node.Children {
-		resp.Namespaces = append(resp.Namespaces, m.mapNamespaceTreeNode(ctx, child))
+	return resp, nil
+}
+
+func (m MeridianGrpcHandler) GetNamespaceHierarchy(ctx context.Context, req *api.GetNamespaceHierarchyReq) (*api.GetNamespaceHierarchyResp, error) {
+	tree, err := m.namespaces.GetHierarchy(nil, domain.MakeNamespaceId(req.OrgId, "default"))
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.NotFound, "namespace hierarchy not found")
+		return nil, err
 	}
-	return resp
+	return m.mapNamespaceTreeNode(ctx, &tree.Root), nil
 }
 
-func (m *MeridianGrpcHandler) sendSeccompProfile(ctx context.Context, strategy string, metadata domain.SeccompProfile, profileDefinition *api.SeccompProfile, parent *domain.Namespace) error {
-	switch strings.ToLower(strategy) {
-	case "redefine":
-		profile := &pulsar_api.SeccompProfileDefinitionRequest{
-			Profile: &pulsar_api.SeccompProfile{
-				Namespace:    metadata.Namespace,
-				Application:  metadata.Application,
-				Name:         metadata.Name,
-				Version:      metadata.Version,
-				Architecture: metadata.Architecture,
-			},
+func (m MeridianGrpcHandler) SetNamespaceResources(ctx context.Context, req *api.SetNamespaceResourcesReq) (*api.SetNamespaceResourcesResp, error) {
+	quotas, err := m.parseQuotas(req.OrgId, req.QuotaQuantities, req.Quotas)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	id := domain.MakeNamespaceId(req.OrgId, req.Name)
+	var available, parentAvailable domain.ResourceQuotas
+	err = m.atomic(req.DryRun, func(tx domain.Tx) error {
+		err := m.setNamespaceResources(ctx, tx, id, quotas)
+		if err != nil {
+			return err
+		}
+		available, err = m.resources.GetAvailableResources(tx, id)
+		if err != nil {
+			return err
+		}
+		parent, err := m.namespaces.GetParent(tx, id)
+		if err != nil || parent == nil {
+			return err
+		}
+		parentAvailable, err = m.resources.GetAvailableResources(tx, parent.GetId())
+		return err
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	if !req.DryRun {
+		m.alerter.CheckWithParent(id)
+	}
+	return &api.SetNamespaceResourcesResp{
+		Available:       m.resourceTypes.FormatResourceQuotas(req.OrgId, available),
+		ParentAvailable: m.resourceTypes.FormatResourceQuotas(req.OrgId, parentAvailable),
+	}, nil
+}
+
+func (m MeridianGrpcHandler) CreateQuotaRequest(ctx context.Context, req *api.CreateQuotaRequestReq) (*api.CreateQuotaRequestResp, error) {
+	requester, err := m.authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = m.namespaces.Get(nil, domain.MakeNamespaceId(req.OrgId, req.Namespace))
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.NotFound, "namespace not found")
+		return nil, err
+	}
+	quotas, err := m.resourceTypes.ParseResourceQuantities(req.OrgId, req.Quotas)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	if len(quotas) == 0 {
+		err = status.Error(codes.InvalidArgument, "at least one quota must be requested")
+		return nil, err
+	}
+	request := domain.NewQuotaRequest(req.OrgId, req.Namespace, requester, req.Justification, quotas, time.Now())
+	err = m.quotaRequests.Add(nil, request)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	return &api.CreateQuotaRequestResp{
+		Request: m.mapQuotaRequest(request),
+	}, nil
+}
+
+func (m MeridianGrpcHandler) ListQuotaRequests(ctx context.Context, req *api.ListQuotaRequestsReq) (*api.ListQuotaRequestsResp, error) {
+	query := domain.QuotaRequestQuery{
+		OrgId:  req.OrgId,
+		Status: domain.QuotaRequestStatus(req.Status),
+	}
+	if req.Namespace != "" {
+		query.NamespaceId = domain.MakeNamespaceId(req.OrgId, req.Namespace)
+	}
+	requests, err := m.quotaRequests.List(nil, query)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	resp := &api.ListQuotaRequestsResp{
+		Requests: make([]*api.QuotaRequest, 0, len(requests)),
+	}
+	for _, request := range requests {
+		resp.Requests = append(resp.Requests, m.mapQuotaRequest(request))
+	}
+	return resp, nil
+}
+
+func (m MeridianGrpcHandler) ApproveQuotaRequest(ctx context.Context, req *api.ApproveQuotaRequestReq) (*api.ApproveQuotaRequestResp, error) {
+	request, err := m.reviewQuotaRequest(ctx, req.OrgId, req.Id, domain.QuotaRequestApproved, req.Comment)
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	m.alerter.CheckWithParent(request.GetNamespaceId())
+	return &api.ApproveQuotaRequestResp{
+		Request: m.mapQuotaRequest(request),
+	}, nil
+}
+
+func (m MeridianGrpcHandler) RejectQuotaRequest(ctx context.Context, req *api.RejectQuotaRequestReq) (*api.RejectQuotaRequestResp, error) {
+	request, err := m.reviewQuotaRequest(ctx, req.OrgId, req.Id, domain.QuotaRequestRejected, req.Comment)
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	return &api.RejectQuotaRequestResp{
+		Request: m.mapQuotaRequest(request),
+	}, nil
+}
+
+func (m MeridianGrpcHandler) SetAppResources(ctx context.Context, req *api.SetAppResourcesReq) (*api.SetAppResourcesResp, error) {
+	quotas, err := m.parseQuotas(req.OrgId, req.QuotaQuantities, req.Quotas)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	id := domain.MakeAppId(req.OrgId, req.Namespace, req.Name)
+	namespaceId := domain.MakeNamespaceId(req.OrgId, req.Namespace)
+	var available domain.ResourceQuotas
+	err = m.atomic(req.DryRun, func(tx domain.Tx) error {
+		err := m.setAppResources(tx, id, quotas)
+		if err != nil {
+			return err
+		}
+		available, err = m.resources.GetAvailableResources(tx, namespaceId)
+		return err
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	if !req.DryRun {
+		m.alerter.Check(namespaceId)
+	}
+	return &api.SetAppResourcesResp{NamespaceAvailable: m.resourceTypes.FormatResourceQuotas(req.OrgId, available)}, nil
+}
+
+func (m MeridianGrpcHandler) TransferQuota(ctx context.Context, req *api.TransferQuotaReq) (*api.TransferQuotaResp, error) {
+	amounts, err := m.resourceTypes.ParseResourceQuantities(req.OrgId, req.Quotas)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	if req.From == nil || req.To == nil {
+		err = status.Error(codes.InvalidArgument, "both from and to must be set")
+		return nil, err
+	}
+	var fromQuotas, toQuotas domain.ResourceQuotas
+	// the namespaces whose quotas or utilization change
+	var changed []string
+	err = m.txManager.Atomic(func(tx domain.Tx) error {
+		from, err := m.getQuotaHolder(tx, req.OrgId, req.From)
+		if err != nil {
+			return err
+		}
+		to, err := m.getQuotaHolder(tx, req.OrgId, req.To)
+		if err != nil {
+			return err
+		}
+		if from.id == to.id {
+			return status.Error(codes.InvalidArgument, "cannot transfer quotas to the same namespace or app")
+		}
+		if commonAncestor(from.ancestors, to.ancestors) == nil {
+			return status.Error(codes.InvalidArgument, "from and to are not under a common ancestor namespace")
+		}
+		changed = append(from.changedNamespaces(), to.changedNamespaces()...)
+		fromQuotas, toQuotas, err = domain.TransferQuotas(from.quotas, to.quotas, amounts)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		for _, holder := range []struct {
+			quotaHolder
+			updated domain.ResourceQuotas
+		}{{from, fromQuotas}, {to, toQuotas}} {
+			if holder.limitRange == nil {
+				err = m.authorizeQuotaChange(ctx, tx, holder.id)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			err = holder.limitRange.Check(holder.updated)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+		// the source is lowered first, so that the quotas it releases are available to the target
+		err = m.resources.SetResourceQuotas(tx, from.id, fromQuotas)
+		if err != nil {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		err = m.resources.SetResourceQuotas(tx, to.id, toQuotas)
+		if err != nil {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		for _, holder := range []quotaHolder{from, to} {
+			if holder.limitRange != nil {
+				err = m.recorder.RecordApps(tx, holder.id)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return m.recorder.RecordNamespaces(tx, changed...)
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	m.alerter.Check(changed...)
+	return &api.TransferQuotaResp{
+		From: m.resourceTypes.FormatResourceQuotas(req.OrgId, fromQuotas),
+		To:   m.resourceTypes.FormatResourceQuotas(req.OrgId, toQuotas),
+	}, nil
+}
+
+// defaultHistoryRange is how far back the quota history goes if the start is not given.
+const defaultHistoryRange = 30 * 24 * time.Hour
+
+func (m MeridianGrpcHandler) GetQuotaHistory(ctx context.Context, req *api.GetQuotaHistoryReq) (*api.GetQuotaHistoryResp, error) {
+	resolution, err := domain.ParseHistoryResolution(req.Resolution)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	to := time.Now()
+	if req.To != 0 {
+		to = time.Unix(req.To, 0)
+	}
+	from := to.Add(-defaultHistoryRange)
+	if req.From != 0 {
+		from = time.Unix(req.From, 0)
+	}
+	if from.After(to) {
+		err = status.Error(codes.InvalidArgument, "from must not be after to")
+		return nil, err
+	}
+	entityId := domain.MakeNamespaceId(req.OrgId, req.Namespace)
+	isApp := req.App != ""
+	if isApp {
+		entityId = domain.MakeAppId(req.OrgId, req.Namespace, req.App)
+	}
+	// removed namespaces and apps have a history too, so the entity is not required to exist
+	samples, err := m.quotaHistory.List(nil, domain.QuotaHistoryQuery{
+		EntityId:  entityId,
+		Resources: req.Resources,
+		From:      from,
+		To:        to,
+	})
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	resp := &api.GetQuotaHistoryResp{}
+	for _, resourceSamples := range domain.GroupByResource(samples) {
+		resource := resourceSamples[0].Resource
+		points, err := resolution.Downsample(resourceSamples, from, to)
+		if err != nil {
+			log.Println(err)
+			err = status.Error(codes.InvalidArgument, err.Error())
+			return nil, err
+		}
+		series := &api.QuotaHistorySeries{
+			Resource: resource,
+			Points:   make([]*api.QuotaHistoryPoint, 0, len(points)),
+		}
+		for _, point := range points {
+			mapped := &api.QuotaHistoryPoint{
+				Timestamp: point.Timestamp.Unix(),
+				Allocated: m.resourceTypes.FormatResourceQuantity(req.OrgId, resource, point.Allocated),
+			}
+			if !isApp {
+				mapped.Available = m.resourceTypes.FormatResourceQuantity(req.OrgId, resource, point.Available)
+				mapped.Utilized = m.resourceTypes.FormatResourceQuantity(req.OrgId, resource, point.Utilized)
+			}
+			series.Points = append(series.Points, mapped)
+		}
+		resp.Series = append(resp.Series, series)
+	}
+	return resp, nil
+}
+
+func (m MeridianGrpcHandler) ScheduleQuotaChange(ctx context.Context, req *api.ScheduleQuotaChangeReq) (*api.ScheduleQuotaChangeResp, error) {
+	quotas, err := m.resourceTypes.ParseResourceQuantities(req.OrgId, req.Quotas)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	if len(quotas) == 0 {
+		err = status.Error(codes.InvalidArgument, "at least one quota must be scheduled")
+		return nil, err
+	}
+	if (req.Cron == "") == (req.RunAt == 0) {
+		err = status.Error(codes.InvalidArgument, "either cron or runAt must be set")
+		return nil, err
+	}
+	runAt := time.Unix(req.RunAt, 0)
+	if req.Cron == "" && !runAt.After(time.Now()) {
+		err = status.Error(codes.InvalidArgument, "runAt must be in the future")
+		return nil, err
+	}
+	change, err := domain.NewScheduledQuotaChange(req.OrgId, req.Namespace, req.App, quotas, req.Cron, runAt)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	err = m.txManager.Atomic(func(tx domain.Tx) error {
+		if req.App != "" {
+			if _, err := m.apps.Get(tx, change.GetEntityId()); err != nil {
+				log.Println(err)
+				return status.Error(codes.NotFound, "app not found")
+			}
+		} else {
+			if _, err := m.namespaces.Get(tx, change.GetEntityId()); err != nil {
+				log.Println(err)
+				return status.Error(codes.NotFound, "namespace not found")
+			}
+			err := m.authorizeQuotaChange(ctx, tx, change.GetEntityId())
+			if err != nil {
+				return err
+			}
+		}
+		return m.schedules.Add(tx, change)
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	return &api.ScheduleQuotaChangeResp{
+		Change: m.mapScheduledQuotaChange(change),
+	}, nil
+}
+
+func (m MeridianGrpcHandler) ListScheduledQuotaChanges(ctx context.Context, req *api.ListScheduledQuotaChangesReq) (*api.ListScheduledQuotaChangesResp, error) {
+	changes, err := m.schedules.List(nil, req.OrgId)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	resp := &api.ListScheduledQuotaChangesResp{
+		Changes: make([]*api.ScheduledQuotaChange, 0, len(changes)),
+	}
+	for _, change := range changes {
+		resp.Changes = append(resp.Changes, m.mapScheduledQuotaChange(change))
+	}
+	return resp, nil
+}
+
+func (m MeridianGrpcHandler) CancelScheduledQuotaChange(ctx context.Context, req *api.CancelScheduledQuotaChangeReq) (*api.CancelScheduledQuotaChangeResp, error) {
+	err := m.txManager.Atomic(func(tx domain.Tx) error {
+		change, err := m.schedules.Get(tx, req.Id)
+		if err != nil {
+			log.Println(err)
+			return status.Error(codes.NotFound, "scheduled quota change not found")
+		}
+		if change.GetOrgId() != req.OrgId {
+			return status.Error(codes.NotFound, "scheduled quota change not found")
+		}
+		if change.GetAppName() == "" {
+			err := m.authorizeQuotaChange(ctx, tx, change.GetEntityId())
+			if err != nil {
+				return err
+			}
+		}
+		return m.schedules.Remove(tx, req.Id)
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	return &api.CancelScheduledQuotaChangeResp{}, nil
+}
+
+func (m MeridianGrpcHandler) SetNamespaceOvercommit(ctx context.Context, req *api.SetNamespaceOvercommitReq) (*api.SetNamespaceOvercommitResp, error) {
+	overcommits, err := m.resourceTypes.ParseOvercommits(req.OrgId, req.Overcommit)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	id := domain.MakeNamespaceId(req.OrgId, req.Name)
+	err = m.txManager.Atomic(func(tx domain.Tx) error {
+		if _, err := m.namespaces.Get(tx, id); err != nil {
+			log.Println(err)
+			return status.Error(codes.NotFound, "namespace not found")
+		}
+		err := m.authorizeQuotaChange(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		err = m.resources.SetOvercommits(tx, id, overcommits)
+		if err != nil {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return m.recorder.RecordNamespaces(tx, id)
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	m.alerter.Check(id)
+	return &api.SetNamespaceOvercommitResp{}, nil
+}
+
+func (m MeridianGrpcHandler) SetNamespaceElasticQuotas(ctx context.Context, req *api.SetNamespaceElasticQuotasReq) (*api.SetNamespaceElasticQuotasResp, error) {
+	elasticMax, err := m.resourceTypes.ParseResourceQuantities(req.OrgId, req.Max)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	id := domain.MakeNamespaceId(req.OrgId, req.Name)
+	err = m.txManager.Atomic(func(tx domain.Tx) error {
+		if _, err := m.namespaces.Get(tx, id); err != nil {
+			log.Println(err)
+			return status.Error(codes.NotFound, "namespace not found")
+		}
+		err := m.authorizeQuotaChange(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		err = m.resources.SetElasticMax(tx, id, elasticMax)
+		if err != nil {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return m.recorder.RecordNamespaces(tx, id)
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	return &api.SetNamespaceElasticQuotasResp{}, nil
+}
+
+func (m MeridianGrpcHandler) SetNamespaceUtilizationThresholds(ctx context.Context, req *api.SetNamespaceUtilizationThresholdsReq) (*api.SetNamespaceUtilizationThresholdsResp, error) {
+	warning := make(map[string]float64)
+	critical := make(map[string]float64)
+	for resource, threshold := range req.Thresholds {
+		if threshold.GetWarning() != 0 {
+			warning[resource] = threshold.GetWarning()
+		}
+		if threshold.GetCritical() != 0 {
+			critical[resource] = threshold.GetCritical()
+		}
+	}
+	thresholds, err := m.resourceTypes.ParseUtilizationThresholds(req.OrgId, warning, critical)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	id := domain.MakeNamespaceId(req.OrgId, req.Name)
+	err = m.txManager.Atomic(func(tx domain.Tx) error {
+		if _, err := m.namespaces.Get(tx, id); err != nil {
+			log.Println(err)
+			return status.Error(codes.NotFound, "namespace not found")
+		}
+		return m.namespaces.SetUtilizationThresholds(tx, id, thresholds)
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	m.alerter.Check(id)
+	return &api.SetNamespaceUtilizationThresholdsResp{}, nil
+}
+
+func (m MeridianGrpcHandler) SetNamespaceLimitRange(ctx context.Context, req *api.SetNamespaceLimitRangeReq) (*api.SetNamespaceLimitRangeResp, error) {
+	limitRange, err := m.parseLimitRange(req.OrgId, req.LimitRange)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	err = limitRange.Validate(req.OrgId, m.resourceTypes)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	id := domain.MakeNamespaceId(req.OrgId, req.Name)
+	err = m.txManager.Atomic(func(tx domain.Tx) error {
+		if _, err := m.namespaces.Get(tx, id); err != nil {
+			log.Println(err)
+			return status.Error(codes.NotFound, "namespace not found")
+		}
+		err := m.authorizeQuotaChange(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		return m.namespaces.SetLimitRange(tx, id, limitRange)
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	return &api.SetNamespaceLimitRangeResp{}, nil
+}
+
+func (m MeridianGrpcHandler) SetNamespaceCountQuotas(ctx context.Context, req *api.SetNamespaceCountQuotasReq) (*api.SetNamespaceCountQuotasResp, error) {
+	countQuotas := domain.CountQuotas{
+		MaxApps:       req.MaxApps,
+		MaxNamespaces: req.MaxNamespaces,
+	}
+	err := countQuotas.Validate()
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	id := domain.MakeNamespaceId(req.OrgId, req.Name)
+	err = m.txManager.Atomic(func(tx domain.Tx) error {
+		counts, err := m.namespaces.GetCounts(tx, id)
+		if err != nil {
+			log.Println(err)
+			return status.Error(codes.NotFound, "namespace not found")
+		}
+		err = m.authorizeQuotaChange(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		err = countQuotas.Check(counts)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return m.namespaces.SetCountQuotas(tx, id, countQuotas)
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	return &api.SetNamespaceCountQuotasResp{}, nil
+}
+
+func (m MeridianGrpcHandler) PutResourceType(ctx context.Context, req *api.PutResourceTypeReq) (*api.PutResourceTypeResp, error) {
+	if req.ResourceType == nil {
+		err := status.Error(codes.InvalidArgument, "resource type missing")
+		return nil, err
+	}
+	resourceType := domain.ResourceType{
+		OrgId:       req.ResourceType.OrgId,
+		Name:        req.ResourceType.Name,
+		Unit:        req.ResourceType.Unit,
+		Description: req.ResourceType.Description,
+		Divisible:   req.ResourceType.Divisible,
+	}
+	err := resourceType.Validate()
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	err = m.txManager.Atomic(func(tx domain.Tx) error {
+		types, err := m.resourceTypeStore.List(tx)
+		if err != nil {
+			return err
+		}
+		var stored *domain.ResourceType
+		for _, existing := range types {
+			if existing.Name != resourceType.Name {
+				continue
+			}
+			if existing.OrgId == resourceType.OrgId {
+				stored = &existing
+				continue
+			}
+			// an org type would shadow the type available to all orgs, and a type
+			// available to all orgs would be shadowed by the one of the org
+			if existing.OrgId == "" || resourceType.OrgId == "" {
+				return status.Errorf(codes.AlreadyExists, "resource type %s already exists for org %q", existing.Name, existing.OrgId)
+			}
+		}
+		// stored quotas are milli units of the unit, which would be reinterpreted
+		if stored != nil && (stored.Unit != resourceType.Unit || stored.Divisible != resourceType.Divisible) {
+			inUse, err := m.resourceTypeStore.InUse(tx, resourceType.OrgId, resourceType.Name)
+			if err != nil {
+				return err
+			}
+			if inUse {
+				return status.Errorf(codes.FailedPrecondition, "unit and divisibility of the resource type %s cannot change while quotas are set for it", resourceType.Name)
+			}
+		}
+		return m.resourceTypeStore.Put(tx, resourceType)
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	m.resourceTypes.Set(resourceType)
+	return &api.PutResourceTypeResp{}, nil
+}
+
+func (m MeridianGrpcHandler) ListResourceTypes(ctx context.Context, req *api.ListResourceTypesReq) (*api.ListResourceTypesResp, error) {
+	resp := &api.ListResourceTypesResp{}
+	for _, resourceType := range m.resourceTypes.List(req.OrgId) {
+		resp.ResourceTypes = append(resp.ResourceTypes, &api.ResourceType{
+			OrgId:       resourceType.OrgId,
+			Name:        resourceType.Name,
+			Unit:        resourceType.Unit,
+			Description: resourceType.Description,
+			Divisible:   resourceType.Divisible,
+		})
+	}
+	return resp, nil
+}
+
+func (m MeridianGrpcHandler) RemoveResourceType(ctx context.Context, req *api.RemoveResourceTypeReq) (*api.RemoveResourceTypeResp, error) {
+	err := m.txManager.Atomic(func(tx domain.Tx) error {
+		inUse, err := m.resourceTypeStore.InUse(tx, req.OrgId, req.Name)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return status.Errorf(codes.FailedPrecondition, "resource type %s is in use", req.Name)
+		}
+		err = m.resourceTypeStore.Remove(tx, req.OrgId, req.Name)
+		if err != nil {
+			log.Println(err)
+			return status.Error(codes.NotFound, "resource type not found")
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	m.resourceTypes.Remove(req.OrgId, req.Name)
+	return &api.RemoveResourceTypeResp{}, nil
+}
+
+func (m MeridianGrpcHandler) PutResourcePrice(ctx context.Context, req *api.PutResourcePriceReq) (*api.PutResourcePriceResp, error) {
+	if req.Price == nil {
+		err := status.Error(codes.InvalidArgument, "price missing")
+		return nil, err
+	}
+	price, err := m.resourceTypes.ParseResourcePrice(req.Price.OrgId, req.Price.Resource, req.Price.Per, req.Price.PerHour)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
+	}
+	err = m.prices.Put(nil, price)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	return &api.PutResourcePriceResp{}, nil
+}
+
+func (m MeridianGrpcHandler) ListResourcePrices(ctx context.Context, req *api.ListResourcePricesReq) (*api.ListResourcePricesResp, error) {
+	prices, err := m.prices.List(nil, req.OrgId)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	resp := &api.ListResourcePricesResp{
+		Prices: make([]*api.ResourcePrice, 0, len(prices)),
+	}
+	for _, price := range prices {
+		resp.Prices = append(resp.Prices, &api.ResourcePrice{
+			OrgId:    price.OrgId,
+			Resource: price.Resource,
+			Per:      m.resourceTypes.FormatResourceQuantity(price.OrgId, price.Resource, price.Per),
+			PerHour:  price.PerHour,
+		})
+	}
+	return resp, nil
+}
+
+func (m MeridianGrpcHandler) RemoveResourcePrice(ctx context.Context, req *api.RemoveResourcePriceReq) (*api.RemoveResourcePriceResp, error) {
+	err := m.prices.Remove(nil, req.OrgId, req.Resource)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.NotFound, err.Error())
+		return nil, err
+	}
+	return &api.RemoveResourcePriceResp{}, nil
+}
+
+func (m MeridianGrpcHandler) GetCostReport(ctx context.Context, req *api.GetCostReportReq) (*api.GetCostReportResp, error) {
+	if req.Format != "" && req.Format != "csv" && req.Format != "json" {
+		err := status.Errorf(codes.InvalidArgument, "unknown format %s, expected csv or json", req.Format)
+		return nil, err
+	}
+	to := time.Now()
+	if req.To != 0 {
+		to = time.Unix(req.To, 0)
+	}
+	from := to.Add(-defaultHistoryRange)
+	if req.From != 0 {
+		from = time.Unix(req.From, 0)
+	}
+	if from.After(to) {
+		err := status.Error(codes.InvalidArgument, "from must not be after to")
+		return nil, err
+	}
+	tree, err := m.namespaces.GetHierarchy(nil, domain.MakeNamespaceId(req.OrgId, req.Namespace))
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.NotFound, "namespace not found")
+		return nil, err
+	}
+	prices, err := m.prices.List(nil, req.OrgId)
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	// samples after the range tell if a namespace has a history at all
+	samples, err := m.quotaHistory.ListSubtree(nil, tree.Root.Namespace.GetId(), from, time.Now())
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	samplesByNamespace := make(map[string][]domain.QuotaSample)
+	for _, sample := range samples {
+		samplesByNamespace[sample.EntityId] = append(samplesByNamespace[sample.EntityId], sample)
+	}
+	allocated := make(map[string]map[string]*big.Rat)
+	_ = tree.Root.WalkBottomUp(func(node *domain.NamespaceTreeNode) error {
+		id := node.Namespace.GetId()
+		namespaceSamples, found := samplesByNamespace[id]
+		if !found {
+			// the quotas of namespaces created before their changes were recorded are taken as constant
+			allocated[id] = domain.ConstantMilliUnitHours(node.Namespace.GetResourceQuotas(), from, to)
+			return nil
+		}
+		allocated[id] = domain.AllocatedMilliUnitHours(namespaceSamples, from, to)
+		return nil
+	})
+	report := domain.NewCostReport(req.OrgId, tree, allocated, prices, from, to, req.GroupByLabel)
+	resp := &api.GetCostReportResp{
+		Namespaces: make([]*api.NamespaceCost, 0, len(report.Namespaces)),
+		Total:      domain.FormatCost(report.Total),
+		Unpriced:   report.Unpriced,
+	}
+	for _, namespace := range report.Namespaces {
+		unitHours := make(map[string]string)
+		for resource, hours := range namespace.MilliUnitHours {
+			unitHours[resource] = domain.FormatUnitHours(hours)
+		}
+		resp.Namespaces = append(resp.Namespaces, &api.NamespaceCost{
+			Name:        namespace.Name,
+			Parent:      namespace.Parent,
+			Labels:      namespace.Labels,
+			UnitHours:   unitHours,
+			Cost:        domain.FormatCost(namespace.Cost),
+			SubtreeCost: domain.FormatCost(namespace.SubtreeCost),
+		})
+	}
+	for _, group := range report.Groups {
+		resp.Groups = append(resp.Groups, &api.CostGroup{
+			Value:      group.Value,
+			Namespaces: group.Namespaces,
+			Cost:       domain.FormatCost(group.Cost),
+		})
+	}
+	switch req.Format {
+	case "csv":
+		resp.Document, err = report.CSV()
+	case "json":
+		resp.Document, err = report.JSON()
+	}
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (m MeridianGrpcHandler) GetUtilizationReport(ctx context.Context, req *api.GetUtilizationReportReq) (*api.GetUtilizationReportResp, error) {
+	options := domain.UtilizationReportOptions{
+		LowUtilization:  domain.DefaultLowUtilization,
+		HighUtilization: domain.DefaultHighUtilization,
+		Top:             domain.DefaultTopConsumers,
+	}
+	if req.LowUtilization != 0 {
+		options.LowUtilization = req.LowUtilization
+	}
+	if req.HighUtilization != 0 {
+		options.HighUtilization = req.HighUtilization
+	}
+	if req.Top != 0 {
+		options.Top = int(req.Top)
+	}
+	if options.LowUtilization < 0 || options.HighUtilization <= options.LowUtilization {
+		err := status.Error(codes.InvalidArgument, "low utilization must not be negative and must be below high utilization")
+		return nil, err
+	}
+	if options.Top < 0 {
+		err := status.Error(codes.InvalidArgument, "top must not be negative")
+		return nil, err
+	}
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	tree, err := m.namespaces.GetHierarchy(nil, domain.MakeNamespaceId(req.OrgId, namespace))
+	if err != nil {
+		log.Println(err)
+		err = status.Error(codes.NotFound, "namespace not found")
+		return nil, err
+	}
+	report := domain.NewUtilizationReport(req.OrgId, tree, options)
+	resp := &api.GetUtilizationReportResp{
+		Namespaces:   make([]*api.NamespaceUtilization, 0, len(report.Namespaces)),
+		TopConsumers: make([]*api.TopConsumers, 0, len(report.TopConsumers)),
+	}
+	for _, namespace := range report.Namespaces {
+		utilization := &api.NamespaceUtilization{
+			Name:            namespace.Name,
+			Parent:          namespace.Parent,
+			OverProvisioned: namespace.OverProvisioned,
+			NearExhaustion:  namespace.NearExhaustion,
+		}
+		for _, resource := range namespace.Resources {
+			utilization.Resources = append(utilization.Resources, &api.ResourceUtilization{
+				Resource:         resource.Resource,
+				Total:            m.resourceTypes.FormatResourceQuantity(req.OrgId, resource.Resource, resource.Total),
+				Available:        m.resourceTypes.FormatResourceQuantity(req.OrgId, resource.Resource, resource.Available),
+				Utilized:         m.resourceTypes.FormatResourceQuantity(req.OrgId, resource.Resource, resource.Utilized),
+				AvailablePercent: resource.AvailablePercent(),
+				UtilizedPercent:  resource.UtilizedPercent(),
+			})
+		}
+		resp.Namespaces = append(resp.Namespaces, utilization)
+	}
+	for _, consumers := range report.TopConsumers {
+		top := &api.TopConsumers{Resource: consumers.Resource}
+		for _, consumer := range consumers.Consumers {
+			top.Consumers = append(top.Consumers, &api.Consumer{
+				Namespace: consumer.Namespace,
+				Consumed:  m.resourceTypes.FormatResourceQuantity(req.OrgId, consumers.Resource, consumer.Consumed),
+				Share:     consumer.Share,
+			})
+		}
+		resp.TopConsumers = append(resp.TopConsumers, top)
+	}
+	return resp, nil
+}
+
+// errRolledBack ends the transaction of a simulation or a dry run, so that none of its changes are committed.
+var errRolledBack = errors.New("rolled back")
+
+func (m MeridianGrpcHandler) Simulate(ctx context.Context, req *api.SimulateReq) (*api.SimulateResp, error) {
+	for i, mutation := range req.Mutations {
+		set := 0
+		for _, request := range []bool{
+			mutation.AddNamespace != nil,
+			mutation.RemoveNamespace != nil,
+			mutation.AddApp != nil,
+			mutation.RemoveApp != nil,
+			mutation.SetNamespaceResources != nil,
+			mutation.SetAppResources != nil,
+		} {
+			if request {
+				set++
+			}
+		}
+		if set != 1 {
+			err := status.Errorf(codes.InvalidArgument, "mutation %d must set exactly one request", i)
+			return nil, err
+		}
+	}
+	resp := &api.SimulateResp{}
+	// the violations of all mutations are collected in a single transaction that is rolled back,
+	// mutations with a violation are skipped
+	err := m.txManager.Atomic(func(tx domain.Tx) error {
+		for i, mutation := range req.Mutations {
+			err := m.applyMutation(ctx, tx, req.OrgId, mutation)
+			if err != nil {
+				resp.Violations = append(resp.Violations, &api.SimulationViolation{
+					Mutation: int32(i),
+					Code:     status.Code(statusError(err)).String(),
+					Message:  status.Convert(err).Message(),
+				})
+			}
+		}
+		tree, err := m.namespaces.GetHierarchy(tx, domain.MakeNamespaceId(req.OrgId, "default"))
+		if err != nil {
+			log.Println(err)
+			return status.Error(codes.NotFound, "namespace hierarchy not found")
+		}
+		resp.Namespaces = m.mapSimulatedNamespaces(&tree.Root, "")
+		return errRolledBack
+	})
+	if err != nil && !errors.Is(err, errRolledBack) {
+		log.Println(err)
+		return nil, statusError(err)
+	}
+	return resp, nil
+}
+
+func (m *MeridianGrpcHandler) mapNamespaceTreeNode(ctx context.Context, node *domain.NamespaceTreeNode) *api.GetNamespaceHierarchyResp {
+	resp := &api.GetNamespaceHierarchyResp{
+		Namespace: &api.GetNamespaceHierarchyResp_Namespace{
+			Name:                node.Namespace.GetName(),
+			Labels:              node.Namespace.GetLabels(),
+			Total:               domain.FormatResourceUnits(node.Namespace.GetResourceQuotas()),
+			TotalQuantities:     m.resourceTypes.FormatResourceQuotas(node.Namespace.GetOrgId(), node.Namespace.GetResourceQuotas()),
+			Available:           domain.FormatResourceUnits(node.Namespace.GetAvailable()),
+			AvailableQuantities: m.resourceTypes.FormatResourceQuotas(node.Namespace.GetOrgId(), node.Namespace.GetAvailable()),
+			Utilized:            domain.FormatResourceUnits(node.Namespace.GetUtilized()),
+			UtilizedQuantities:  m.resourceTypes.FormatResourceQuotas(node.Namespace.GetOrgId(), node.Namespace.GetUtilized()),
+			Profile:             m.getSeccompProfile(ctx, node.Namespace.GetSeccompProfile()),
+		},
+	}
+	for _, app := range node.Apps {
+		resp.Apps = append(resp.Apps, &api.GetNamespaceHierarchyResp_App{
+			Name:            app.GetName(),
+			Total:           domain.FormatResourceUnits(app.GetResourceQuotas()),
+			TotalQuantities: m.resourceTypes.FormatResourceQuotas(app.GetNamespace().GetOrgId(), app.GetResourceQuotas()),
+			Profile:         m.getSeccompProfile(ctx, app.GetSeccompProfile()),
+		})
+	}
+	for _, child := range node.Children {
+		resp.Namespaces = append(resp.Namespaces, m.mapNamespaceTreeNode(ctx, child))
+	}
+	return resp
+}
+
+// sendSeccompProfile defines the seccomp profile in pulsar with a strategy returned by resolveSeccompStrategy.
+func (m *MeridianGrpcHandler) sendSeccompProfile(ctx context.Context, strategy string, metadata domain.SeccompProfile, profileDefinition *api.SeccompProfile, parent *domain.Namespace) error {
+	switch strategy {
+	case "redefine":
+		profile := &pulsar_api.SeccompProfileDefinitionRequest{
+			Profile: &pulsar_api.SeccompProfile{
+				Namespace:    metadata.Namespace,
+				Application:  metadata.Application,
+				Name:         metadata.Name,
+				Version:      metadata.Version,
+				Architecture: metadata.Architecture,
+			},
 			Definition: &pulsar_api.SeccompProfileDefinition{
 				DefaultAction: profileDefinition.DefaultAction,
 				Architectures: []string{metadata.Architecture},
@@ -290,116 +1656,822 @@ func (m *MeridianGrpcHandler) sendSeccompProfile(ctx context.Context, strategy s
 				Action: syscall.Action,
 			})
 		}
-		_, err := m.pulsar.DefineSeccompProfile(ctx, profile)
+		_, err := m.pulsar.DefineSeccompProfile(ctx, profile)
+		if err != nil {
+			return err
+		}
+	case "extend":
+		profile := &pulsar_api.ExtendSeccompProfileRequest{
+			ExtendProfile: &pulsar_api.SeccompProfile{
+				Namespace:    parent.GetId(),
+				Application:  parent.GetSeccompProfile().Application,
+				Name:         parent.GetSeccompProfile().Name,
+				Version:      parent.GetSeccompProfile().Version,
+				Architecture: parent.GetSeccompProfile().Architecture,
+			},
+			DefineProfile: &pulsar_api.SeccompProfile{
+				Namespace:    metadata.Namespace,
+				Application:  metadata.Application,
+				Name:         metadata.Name,
+				Version:      metadata.Version,
+				Architecture: metadata.Architecture,
+			},
+		}
+		for _, syscall := range profileDefinition.Syscalls {
+			profile.Syscalls = append(profile.Syscalls, &pulsar_api.Syscalls{
+				Names:  syscall.Names,
+				Action: syscall.Action,
+			})
+		}
+		_, err := m.pulsar.ExtendSeccompProfile(ctx, profile)
+		if err != nil {
+			return err
+		}
+	// inherit
+	default:
+		profile, err := m.pulsar.GetSeccompProfile(ctx, &pulsar_api.SeccompProfile{
+			Namespace:    parent.GetSeccompProfile().Namespace,
+			Application:  parent.GetSeccompProfile().Application,
+			Name:         parent.GetSeccompProfile().Name,
+			Version:      parent.GetSeccompProfile().Version,
+			Architecture: parent.GetSeccompProfile().Architecture,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = m.pulsar.DefineSeccompProfile(ctx, &pulsar_api.SeccompProfileDefinitionRequest{
+			Profile: &pulsar_api.SeccompProfile{
+				Namespace:    metadata.Namespace,
+				Application:  metadata.Application,
+				Name:         metadata.Name,
+				Version:      metadata.Version,
+				Architecture: metadata.Architecture,
+			},
+			Definition: profile.Definition,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanUpNamespaceTree undeploys the apps and deletes the seccomp profiles and oort relations
+// of removed namespaces, child namespaces before their parents.
+func (m *MeridianGrpcHandler) cleanUpNamespaceTree(ctx context.Context, orgId string, node *domain.NamespaceTreeNode, parent *domain.Namespace) {
+	for _, child := range node.Children {
+		m.cleanUpNamespaceTree(ctx, orgId, child, node.Namespace)
+	}
+	for _, app := range node.Apps {
+		m.cleanUpApp(ctx, app)
+	}
+	m.deleteSeccompProfile(ctx, node.Namespace.GetSeccompProfile())
+	err := m.administrator.SendRequest(&oortapi.DeleteInheritanceRelReq{
+		From: parentResource(orgId, parent),
+		To: &oortapi.Resource{
+			Id:   node.Namespace.GetId(),
+			Kind: "namespace",
+		},
+	}, func(resp *oortapi.AdministrationAsyncResp) {
+		log.Println(resp.Error)
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// cleanUpApp removes the config of a removed app from the nodes it was disseminated to and deletes its seccomp profile.
+func (m *MeridianGrpcHandler) cleanUpApp(ctx context.Context, app domain.App) {
+	cmd := api.RemoveAppConfigCommand{
+		OrgId:         app.GetNamespace().GetOrgId(),
+		NamespaceName: app.GetNamespace().GetName(),
+		AppName:       app.GetName(),
+	}
+	cmdMarshalled, err := proto.Marshal(&cmd)
+	if err != nil {
+		log.Println(err)
+	} else {
+		for _, node := range app.GetNodes() {
+			err = m.publisher.Publish(cmdMarshalled, api.RemoveSubject(node))
+			if err != nil {
+				log.Println(err)
+			}
+		}
+	}
+	m.deleteSeccompProfile(ctx, app.GetSeccompProfile())
+}
+
+func (m *MeridianGrpcHandler) deleteSeccompProfile(ctx context.Context, metadata domain.SeccompProfile) {
+	_, err := m.pulsar.DeleteSeccompProfile(ctx, &pulsar_api.SeccompProfile{
+		Namespace:    metadata.Namespace,
+		Application:  metadata.Application,
+		Name:         metadata.Name,
+		Version:      metadata.Version,
+		Architecture: metadata.Architecture,
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (m *MeridianGrpcHandler) getSeccompProfile(ctx context.Context, metadata domain.SeccompProfile) *api.SeccompProfile {
+	resp, err := m.pulsar.GetSeccompProfile(ctx, &pulsar_api.SeccompProfile{
+		Namespace:    metadata.Namespace,
+		Application:  metadata.Application,
+		Name:         metadata.Name,
+		Version:      metadata.Version,
+		Architecture: metadata.Architecture,
+	})
+	if err != nil {
+		log.Println(err)
+		return nil
+	}
+	profile := &api.SeccompProfile{
+		Version:       metadata.Version,
+		DefaultAction: resp.Definition.DefaultAction,
+	}
+	for _, syscall := range resp.Definition.Syscalls {
+		profile.Syscalls = append(profile.Syscalls, &api.SyscallRule{
+			Names:  syscall.Names,
+			Action: syscall.Action,
+		})
+	}
+	return profile
+}
+
+func (m *MeridianGrpcHandler) placeByGossip(ctx context.Context, org string, percentage int32) ([]*magnetarapi.NodeStringified, error) {
+	queryReq := &magnetarapi.ListOrgOwnedNodesReq{
+		Org: string(org),
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		log.Println("no metadata in ctx when sending req to magnetar")
+	} else {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+	queryResp, err := m.magnetar.ListOrgOwnedNodes(ctx, queryReq)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := selectRandmNodes(queryResp.Nodes, percentage)
+	return nodes, nil
+}
+
+// newNamespace validates the request and builds the namespace it adds, along with its parent.
+func (m *MeridianGrpcHandler) newNamespace(tx domain.Tx, req *api.AddNamespaceReq) (domain.Namespace, *domain.Namespace, error) {
+	namespace, err := m.namespaces.Get(tx, domain.MakeNamespaceId(req.OrgId, req.Name))
+	if err == nil {
+		return domain.Namespace{}, nil, status.Error(codes.AlreadyExists, "namespace already exists")
+	}
+	var parent *domain.Namespace
+	if req.ParentName != "" {
+		p, err := m.namespaces.Get(tx, domain.MakeNamespaceId(req.OrgId, req.ParentName))
+		if err != nil {
+			log.Println(err)
+			return domain.Namespace{}, nil, status.Error(codes.NotFound, "parent namespace not found")
+		}
+		parent = &p
+	}
+	err = domain.ValidateLabels(req.Labels)
+	if err != nil {
+		log.Println(err)
+		return domain.Namespace{}, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	quotas, err := m.parseQuotas(req.OrgId, req.QuotaQuantities, req.Quotas)
+	if err != nil {
+		log.Println(err)
+		return domain.Namespace{}, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	namespace = domain.NewNamespace(req.OrgId, req.Name, req.Profile.GetVersion(), req.Labels)
+	for resource, quota := range quotas {
+		err := namespace.AddResourceQuota(resource, quota)
+		if err != nil {
+			log.Println(err)
+			return domain.Namespace{}, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return namespace, parent, nil
+}
+
+// addNamespace adds the namespace if its parent has room for it in tx,
+// nothing is written if it does not.
+func (m *MeridianGrpcHandler) addNamespace(tx domain.Tx, namespace domain.Namespace, parent *domain.Namespace) error {
+	if parent != nil {
+		current, err := m.namespaces.Get(tx, parent.GetId())
+		if err != nil {
+			log.Println(err)
+			return status.Error(codes.NotFound, "parent namespace not found")
+		}
+		err = namespace.CheckFitsIn(current)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		ancestors, err := m.ancestors(tx, parent)
+		if err != nil {
+			return err
+		}
+		err = m.checkCountQuotas(tx, ancestors, domain.ObjectCounts{Namespaces: 1})
+		if err != nil {
+			return err
+		}
+	}
+	err := m.namespaces.Add(tx, namespace, parent)
+	if err != nil {
+		return err
+	}
+	if parent != nil {
+		return m.recorder.RecordNamespaces(tx, namespace.GetId(), parent.GetId())
+	}
+	return m.recorder.RecordNamespaces(tx, namespace.GetId())
+}
+
+// removedNamespaceTree returns the subtree the removal of the namespace takes with it, along with its parent.
+func (m *MeridianGrpcHandler) removedNamespaceTree(tx domain.Tx, id string, cascade bool) (domain.NamespaceTree, *domain.Namespace, error) {
+	tree, err := m.namespaces.GetHierarchy(tx, id)
+	if err != nil {
+		log.Println(err)
+		return domain.NamespaceTree{}, nil, status.Error(codes.NotFound, "namespace not found")
+	}
+	if !cascade && (len(tree.Root.Children) > 0 || len(tree.Root.Apps) > 0) {
+		return domain.NamespaceTree{}, nil, status.Error(codes.InvalidArgument, "namespace must not have applications or child namespaces")
+	}
+	parent, err := m.namespaces.GetParent(tx, id)
+	if err != nil {
+		return domain.NamespaceTree{}, nil, err
+	}
+	return tree, parent, nil
+}
+
+// removeNamespaceTree removes the apps and namespaces of the tree, children before their parents.
+func (m *MeridianGrpcHandler) removeNamespaceTree(tx domain.Tx, tree domain.NamespaceTree, parent *domain.Namespace) error {
+	err := tree.Root.WalkBottomUp(func(node *domain.NamespaceTreeNode) error {
+		for _, app := range node.Apps {
+			err := m.apps.Remove(tx, app.GetId())
+			if err != nil {
+				return err
+			}
+			err = m.recorder.RecordRemovedApp(tx, app)
+			if err != nil {
+				return err
+			}
+		}
+		err := m.namespaces.Remove(tx, node.Namespace.GetId())
 		if err != nil {
 			return err
 		}
-	case "extend":
-		if parent == nil {
-			return status.Error(codes.InvalidArgument, "cannot inherit or extend seccomp profiles - there is no parent")
+		return m.recorder.RecordRemovedNamespace(tx, *node.Namespace)
+	})
+	if err != nil || parent == nil {
+		return err
+	}
+	return m.recorder.RecordNamespaces(tx, parent.GetId())
+}
+
+// newApp validates the request and builds the app it adds, along with its namespace and
+// the id of the reservation the app takes the quotas of.
+func (m *MeridianGrpcHandler) newApp(tx domain.Tx, req *api.AddAppReq) (domain.App, domain.Namespace, string, error) {
+	namespace, err := m.namespaces.Get(tx, domain.MakeNamespaceId(req.OrgId, req.Namespace))
+	if err != nil {
+		log.Println(err)
+		return domain.App{}, domain.Namespace{}, "", status.Error(codes.NotFound, "namespace not found")
+	}
+	if _, err := m.apps.Get(tx, domain.MakeAppId(req.OrgId, req.Namespace, req.Name)); err == nil {
+		return domain.App{}, domain.Namespace{}, "", status.Error(codes.AlreadyExists, "app already exists")
+	}
+	quotas, err := m.parseQuotas(req.OrgId, req.QuotaQuantities, req.Quotas)
+	if err != nil {
+		log.Println(err)
+		return domain.App{}, domain.Namespace{}, "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	var reservationId string
+	if req.Reservation != "" {
+		reservationId = domain.MakeReservationId(req.OrgId, req.Namespace, req.Reservation)
+		reservation, err := m.getReservation(tx, reservationId)
+		if err != nil {
+			return domain.App{}, domain.Namespace{}, "", err
 		}
-		profile := &pulsar_api.ExtendSeccompProfileRequest{
-			ExtendProfile: &pulsar_api.SeccompProfile{
-				Namespace:    parent.GetId(),
-				Application:  parent.GetSeccompProfile().Application,
-				Name:         parent.GetSeccompProfile().Name,
-				Version:      parent.GetSeccompProfile().Version,
-				Architecture: parent.GetSeccompProfile().Architecture,
-			},
-			DefineProfile: &pulsar_api.SeccompProfile{
-				Namespace:    metadata.Namespace,
-				Application:  metadata.Application,
-				Name:         metadata.Name,
-				Version:      metadata.Version,
-				Architecture: metadata.Architecture,
-			},
+		reserved := reservation.GetResourceQuotas()
+		maps.Copy(reserved, quotas)
+		quotas = reserved
+	}
+	limitRange := namespace.GetLimitRange()
+	quotas = limitRange.ApplyDefaults(quotas)
+	err = limitRange.Check(quotas)
+	if err != nil {
+		log.Println(err)
+		return domain.App{}, domain.Namespace{}, "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	app := domain.NewApp(namespace, req.Name, req.Profile.GetVersion())
+	for resource, quota := range quotas {
+		err := app.AddResourceQuota(resource, quota)
+		if err != nil {
+			log.Println(err)
+			return domain.App{}, domain.Namespace{}, "", status.Error(codes.InvalidArgument, err.Error())
 		}
-		for _, syscall := range profileDefinition.Syscalls {
-			profile.Syscalls = append(profile.Syscalls, &pulsar_api.Syscalls{
-				Names:  syscall.Names,
-				Action: syscall.Action,
-			})
+	}
+	return app, namespace, reservationId, nil
+}
+
+// addApp adds the app if its namespace has room for it in tx, releasing the reservation if there is one.
+// Nothing is written if the namespace has no room for the app.
+func (m *MeridianGrpcHandler) addApp(tx domain.Tx, app domain.App, namespace domain.Namespace, reservationId string) error {
+	current, err := m.namespaces.Get(tx, namespace.GetId())
+	if err != nil {
+		log.Println(err)
+		return status.Error(codes.NotFound, "namespace not found")
+	}
+	available := current.GetAvailable()
+	if reservationId != "" {
+		reservation, err := m.getReservation(tx, reservationId)
+		if err != nil {
+			return err
 		}
-		_, err := m.pulsar.ExtendSeccompProfile(ctx, profile)
+		// the app takes the reserved quotas
+		for resource, quota := range reservation.GetResourceQuotas() {
+			available[resource] += quota
+		}
+	}
+	err = app.CheckFitsIn(available)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	ancestors, err := m.ancestors(tx, &namespace)
+	if err != nil {
+		return err
+	}
+	err = m.checkCountQuotas(tx, ancestors, domain.ObjectCounts{Apps: 1})
+	if err != nil {
+		return err
+	}
+	if reservationId != "" {
+		// released in the same transaction, so that the app can take the reserved quotas
+		err = m.reservations.Remove(tx, reservationId)
 		if err != nil {
 			return err
 		}
-	// inherit
-	default:
-		if parent == nil {
-			return status.Error(codes.InvalidArgument, "cannot inherit or extend seccomp profiles - there is no parent")
+	}
+	err = m.apps.Add(tx, app)
+	if err != nil {
+		return err
+	}
+	err = m.recorder.RecordApps(tx, app.GetId())
+	if err != nil {
+		return err
+	}
+	return m.recorder.RecordNamespaces(tx, namespace.GetId())
+}
+
+// removeApp removes the app and returns it.
+func (m *MeridianGrpcHandler) removeApp(tx domain.Tx, id string) (domain.App, error) {
+	app, err := m.apps.Get(tx, id)
+	if err != nil {
+		log.Println(err)
+		return domain.App{}, status.Error(codes.NotFound, "app not found")
+	}
+	err = m.apps.Remove(tx, id)
+	if err != nil {
+		return domain.App{}, err
+	}
+	err = m.recorder.RecordRemovedApp(tx, app)
+	if err != nil {
+		return domain.App{}, err
+	}
+	return app, m.recorder.RecordNamespaces(tx, app.GetNamespace().GetId())
+}
+
+func (m *MeridianGrpcHandler) setNamespaceResources(ctx context.Context, tx domain.Tx, id string, quotas domain.ResourceQuotas) error {
+	err := m.authorizeQuotaChange(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	err = m.resources.SetResourceQuotas(tx, id, quotas)
+	if err != nil {
+		return err
+	}
+	return m.recorder.RecordNamespaceWithParent(tx, id)
+}
+
+func (m *MeridianGrpcHandler) setAppResources(tx domain.Tx, id string, quotas domain.ResourceQuotas) error {
+	app, err := m.apps.Get(tx, id)
+	if err != nil {
+		log.Println(err)
+		return status.Error(codes.NotFound, "app not found")
+	}
+	namespace, err := m.namespaces.Get(tx, app.GetNamespace().GetId())
+	if err != nil {
+		return err
+	}
+	// quotas that are not set are kept as they are
+	updated := app.GetResourceQuotas()
+	maps.Copy(updated, quotas)
+	err = namespace.GetLimitRange().Check(updated)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = m.resources.SetResourceQuotas(tx, id, quotas)
+	if err != nil {
+		return err
+	}
+	err = m.recorder.RecordApps(tx, id)
+	if err != nil {
+		return err
+	}
+	return m.recorder.RecordNamespaces(tx, namespace.GetId())
+}
+
+// applyMutation applies the mutation in tx with the validation of its rpc, but without calling other services.
+// The helpers of the rpcs validate before they write, so a mutation with a violation leaves tx as it was.
+// The requests of the mutation are not modified.
+func (m *MeridianGrpcHandler) applyMutation(ctx context.Context, tx domain.Tx, orgId string, mutation *api.SimulatedMutation) error {
+	switch {
+	case mutation.AddNamespace != nil:
+		req := proto.Clone(mutation.AddNamespace).(*api.AddNamespaceReq)
+		req.OrgId = orgId
+		namespace, parent, err := m.newNamespace(tx, req)
+		if err != nil {
+			return err
 		}
-		profile, err := m.pulsar.GetSeccompProfile(ctx, &pulsar_api.SeccompProfile{
-			Namespace:    parent.GetSeccompProfile().Namespace,
-			Application:  parent.GetSeccompProfile().Application,
-			Name:         parent.GetSeccompProfile().Name,
-			Version:      parent.GetSeccompProfile().Version,
-			Architecture: parent.GetSeccompProfile().Architecture,
-		})
+		if _, err := resolveSeccompStrategy(req.SeccompDefinitionStrategy, parent); err != nil {
+			return err
+		}
+		return m.addNamespace(tx, namespace, parent)
+	case mutation.RemoveNamespace != nil:
+		req := mutation.RemoveNamespace
+		tree, parent, err := m.removedNamespaceTree(tx, domain.MakeNamespaceId(orgId, req.Name), req.Cascade)
 		if err != nil {
 			return err
 		}
-		_, err = m.pulsar.DefineSeccompProfile(ctx, &pulsar_api.SeccompProfileDefinitionRequest{
-			Profile: &pulsar_api.SeccompProfile{
-				Namespace:    metadata.Namespace,
-				Application:  metadata.Application,
-				Name:         metadata.Name,
-				Version:      metadata.Version,
-				Architecture: metadata.Architecture,
-			},
-			Definition: profile.Definition,
-		})
+		return m.removeNamespaceTree(tx, tree, parent)
+	case mutation.AddApp != nil:
+		req := proto.Clone(mutation.AddApp).(*api.AddAppReq)
+		req.OrgId = orgId
+		app, namespace, reservationId, err := m.newApp(tx, req)
 		if err != nil {
 			return err
 		}
+		if _, err := resolveSeccompStrategy(req.SeccompDefinitionStrategy, &namespace); err != nil {
+			return err
+		}
+		return m.addApp(tx, app, namespace, reservationId)
+	case mutation.RemoveApp != nil:
+		req := mutation.RemoveApp
+		_, err := m.removeApp(tx, domain.MakeAppId(orgId, req.Namespace, req.Name))
+		return err
+	case mutation.SetNamespaceResources != nil:
+		req := mutation.SetNamespaceResources
+		quotas, err := m.parseQuotas(orgId, req.QuotaQuantities, req.Quotas)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return m.setNamespaceResources(ctx, tx, domain.MakeNamespaceId(orgId, req.Name), quotas)
+	case mutation.SetAppResources != nil:
+		req := mutation.SetAppResources
+		quotas, err := m.parseQuotas(orgId, req.QuotaQuantities, req.Quotas)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return m.setAppResources(tx, domain.MakeAppId(orgId, req.Namespace, req.Name), quotas)
+	default:
+		return status.Error(codes.InvalidArgument, "mutation has no request")
 	}
-	return nil
 }
 
-func (m *MeridianGrpcHandler) getSeccompProfile(ctx context.Context, metadata domain.SeccompProfile) *api.SeccompProfile {
-	resp, err := m.pulsar.GetSeccompProfile(ctx, &pulsar_api.SeccompProfile{
-		Namespace:    metadata.Namespace,
-		Application:  metadata.Application,
-		Name:         metadata.Name,
-		Version:      metadata.Version,
-		Architecture: metadata.Architecture,
+// atomic runs fn in a transaction, which is rolled back instead of committed for dry runs.
+func (m *MeridianGrpcHandler) atomic(dryRun bool, fn func(tx domain.Tx) error) error {
+	err := m.txManager.Atomic(func(tx domain.Tx) error {
+		err := fn(tx)
+		if err == nil && dryRun {
+			return errRolledBack
+		}
+		return err
+	})
+	if errors.Is(err, errRolledBack) {
+		return nil
+	}
+	return err
+}
+
+// ancestors returns the namespace and all of its ancestors, parents before their parents.
+func (m *MeridianGrpcHandler) ancestors(tx domain.Tx, namespace *domain.Namespace) ([]domain.Namespace, error) {
+	ancestors := make([]domain.Namespace, 0)
+	for namespace != nil {
+		ancestors = append(ancestors, *namespace)
+		var err error
+		namespace, err = m.namespaces.GetParent(tx, namespace.GetId())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ancestors, nil
+}
+
+// reviewQuotaRequest records the decision of a user who manages the quotas of the requesting namespace,
+// the requested quotas are set in the same transaction if the request is approved.
+func (m *MeridianGrpcHandler) reviewQuotaRequest(ctx context.Context, orgId, id string, decision domain.QuotaRequestStatus, comment string) (domain.QuotaRequest, error) {
+	var request domain.QuotaRequest
+	err := m.txManager.Atomic(func(tx domain.Tx) error {
+		var err error
+		request, err = m.quotaRequests.Get(tx, id)
+		if err != nil {
+			log.Println(err)
+			return status.Error(codes.NotFound, "quota request not found")
+		}
+		if request.GetOrgId() != orgId {
+			return status.Error(codes.NotFound, "quota request not found")
+		}
+		if !request.IsPending() {
+			return status.Errorf(codes.FailedPrecondition, "quota request is already %s", request.GetStatus())
+		}
+		reviewer, err := m.authorizeQuotaManager(ctx, tx, request.GetNamespaceId())
+		if err != nil {
+			return err
+		}
+		if decision == domain.QuotaRequestApproved {
+			err = m.resources.SetResourceQuotas(tx, request.GetNamespaceId(), request.GetResourceQuotas())
+			if err != nil {
+				return status.Error(codes.FailedPrecondition, err.Error())
+			}
+			err = m.recorder.RecordNamespaceWithParent(tx, request.GetNamespaceId())
+			if err != nil {
+				return err
+			}
+		}
+		request.Review(decision, reviewer, comment, time.Now())
+		return m.quotaRequests.SetReview(tx, request)
 	})
+	if err != nil {
+		return domain.QuotaRequest{}, err
+	}
+	return request, nil
+}
+
+// getReservation returns the reservation unless it is missing or expired and waits to be swept.
+func (m *MeridianGrpcHandler) getReservation(tx domain.Tx, id string) (domain.Reservation, error) {
+	reservation, err := m.reservations.Get(tx, id)
 	if err != nil {
 		log.Println(err)
-		return nil
+		return domain.Reservation{}, status.Error(codes.NotFound, "reservation not found")
 	}
-	profile := &api.SeccompProfile{
-		Version:       metadata.Version,
-		DefaultAction: resp.Definition.DefaultAction,
+	if reservation.IsExpired(time.Now()) {
+		return domain.Reservation{}, status.Error(codes.NotFound, "reservation expired")
 	}
-	for _, syscall := range resp.Definition.Syscalls {
-		profile.Syscalls = append(profile.Syscalls, &api.SyscallRule{
-			Names:  syscall.Names,
-			Action: syscall.Action,
-		})
+	return reservation, nil
+}
+
+// quotaHolder is a namespace or an app whose quotas are transferred.
+type quotaHolder struct {
+	id     string
+	quotas domain.ResourceQuotas
+	// the namespace of an app or the namespace itself, followed by the namespaces above it
+	ancestors []domain.Namespace
+	// nil for namespaces
+	limitRange *domain.LimitRange
+}
+
+// changedNamespaces returns the namespaces whose quotas or utilization change with the quotas of the holder,
+// which are a namespace and its parent or the namespace of an app.
+func (h quotaHolder) changedNamespaces() []string {
+	count := 1
+	if h.limitRange == nil {
+		count = 2
 	}
-	return profile
+	changed := make([]string, 0, count)
+	for _, namespace := range h.ancestors[:min(count, len(h.ancestors))] {
+		changed = append(changed, namespace.GetId())
+	}
+	return changed
 }
 
-func (m *MeridianGrpcHandler) placeByGossip(ctx context.Context, org string, percentage int32) ([]*magnetarapi.NodeStringified, error) {
-	queryReq := &magnetarapi.ListOrgOwnedNodesReq{
-		Org: string(org),
+func (m *MeridianGrpcHandler) getQuotaHolder(tx domain.Tx, orgId string, holder *api.QuotaHolder) (quotaHolder, error) {
+	namespace, err := m.namespaces.Get(tx, domain.MakeNamespaceId(orgId, holder.Namespace))
+	if err != nil {
+		log.Println(err)
+		return quotaHolder{}, status.Errorf(codes.NotFound, "namespace %s not found", holder.Namespace)
 	}
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		log.Println("no metadata in ctx when sending req to magnetar")
-	} else {
-		ctx = metadata.NewOutgoingContext(ctx, md)
+	if holder.App != "" {
+		app, err := m.apps.Get(tx, domain.MakeAppId(orgId, holder.Namespace, holder.App))
+		if err != nil {
+			log.Println(err)
+			return quotaHolder{}, status.Errorf(codes.NotFound, "app %s not found", holder.App)
+		}
+		ancestors, err := m.ancestors(tx, &namespace)
+		if err != nil {
+			return quotaHolder{}, err
+		}
+		limitRange := namespace.GetLimitRange()
+		return quotaHolder{
+			id:         app.GetId(),
+			quotas:     app.GetResourceQuotas(),
+			ancestors:  ancestors,
+			limitRange: &limitRange,
+		}, nil
 	}
-	queryResp, err := m.magnetar.ListOrgOwnedNodes(ctx, queryReq)
+	// a namespace is its own ancestor, so that it can transfer quotas to and from its descendants
+	ancestors, err := m.ancestors(tx, &namespace)
 	if err != nil {
-		return nil, err
+		return quotaHolder{}, err
+	}
+	return quotaHolder{
+		id:        namespace.GetId(),
+		quotas:    namespace.GetResourceQuotas(),
+		ancestors: ancestors,
+	}, nil
+}
+
+// commonAncestor returns the closest namespace found in both lists of ancestors, or nil if there is none.
+func commonAncestor(ancestors, others []domain.Namespace) *domain.Namespace {
+	for i := range ancestors {
+		if slices.ContainsFunc(others, func(other domain.Namespace) bool {
+			return other.GetId() == ancestors[i].GetId()
+		}) {
+			return &ancestors[i]
+		}
 	}
+	return nil
+}
 
-	fmt.Printf("queryResp.Nodes: %+v\n", queryResp.Nodes)
+// checkCountQuotas returns an error if adding apps and namespaces to the subtrees of the namespaces exceeds their count quotas.
+func (m *MeridianGrpcHandler) checkCountQuotas(tx domain.Tx, namespaces []domain.Namespace, added domain.ObjectCounts) error {
+	for _, namespace := range namespaces {
+		if !namespace.GetCountQuotas().IsLimited() {
+			continue
+		}
+		counts, err := m.namespaces.GetCounts(tx, namespace.GetId())
+		if err != nil {
+			return err
+		}
+		err = namespace.GetCountQuotas().Check(counts.Add(added))
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "namespace %s: %s", namespace.GetName(), err.Error())
+		}
+	}
+	return nil
+}
 
-	nodes := selectRandmNodes(queryResp.Nodes, percentage)
-	return nodes, nil
+// statusError keeps the errors that already carry a grpc status and reports all others as internal.
+func statusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// parseQuotas reads the quota quantities of a request, or the deprecated quotas in units
+// of the resource types if a client that does not use quantities yet sent none.
+func (m *MeridianGrpcHandler) parseQuotas(orgId string, quantities map[string]string, units map[string]float64) (domain.ResourceQuotas, error) {
+	if len(quantities) == 0 && len(units) > 0 {
+		return m.resourceTypes.ParseResourceUnits(orgId, units)
+	}
+	return m.resourceTypes.ParseResourceQuantities(orgId, quantities)
+}
+
+// resolveSeccompStrategy returns the strategy a seccomp profile is defined with, which is inherit
+// unless redefine or extend is requested. Only redefine works without a parent namespace.
+func resolveSeccompStrategy(strategy string, parent *domain.Namespace) (string, error) {
+	resolved := strings.ToLower(strategy)
+	if resolved != "redefine" && resolved != "extend" {
+		resolved = "inherit"
+	}
+	if resolved != "redefine" && parent == nil {
+		return "", status.Error(codes.InvalidArgument, "cannot inherit or extend seccomp profiles - there is no parent")
+	}
+	return resolved, nil
+}
+
+// parentResource returns the oort resource a namespace inherits from,
+// which is the org itself for top-level namespaces.
+func parentResource(orgId string, parent *domain.Namespace) *oortapi.Resource {
+	if parent == nil {
+		return &oortapi.Resource{
+			Id:   orgId,
+			Kind: "org",
+		}
+	}
+	return &oortapi.Resource{
+		Id:   parent.GetId(),
+		Kind: "namespace",
+	}
+}
+
+// parseLimitRange converts the quantities of a limit range into quotas, a missing limit range has no limits.
+func (m *MeridianGrpcHandler) parseLimitRange(orgId string, limitRange *api.LimitRange) (domain.LimitRange, error) {
+	parsed := domain.NewLimitRange()
+	if limitRange == nil {
+		return parsed, nil
+	}
+	var err error
+	if parsed.Defaults, err = m.resourceTypes.ParseResourceQuantities(orgId, limitRange.Defaults); err != nil {
+		return domain.LimitRange{}, err
+	}
+	if parsed.Min, err = m.resourceTypes.ParseResourceQuantities(orgId, limitRange.Min); err != nil {
+		return domain.LimitRange{}, err
+	}
+	if parsed.Max, err = m.resourceTypes.ParseResourceQuantities(orgId, limitRange.Max); err != nil {
+		return domain.LimitRange{}, err
+	}
+	for _, ratio := range limitRange.MaxRatios {
+		max, err := m.resourceTypes.ParseResourceQuantities(orgId, map[string]string{ratio.Resource: ratio.Max})
+		if err != nil {
+			return domain.LimitRange{}, err
+		}
+		parsed.MaxRatios = append(parsed.MaxRatios, domain.ResourceRatio{
+			Resource:    ratio.Resource,
+			PerResource: ratio.PerResource,
+			Max:         max[ratio.Resource],
+		})
+	}
+	return parsed, nil
+}
+
+// mapSimulatedNamespaces lists the namespaces of the tree, parents before their children.
+func (m *MeridianGrpcHandler) mapSimulatedNamespaces(node *domain.NamespaceTreeNode, parent string) []*api.SimulatedNamespace {
+	orgId := node.Namespace.GetOrgId()
+	namespaces := []*api.SimulatedNamespace{{
+		Name:      node.Namespace.GetName(),
+		Parent:    parent,
+		Total:     m.resourceTypes.FormatResourceQuotas(orgId, node.Namespace.GetResourceQuotas()),
+		Available: m.resourceTypes.FormatResourceQuotas(orgId, node.Namespace.GetAvailable()),
+		Utilized:  m.resourceTypes.FormatResourceQuotas(orgId, node.Namespace.GetUtilized()),
+	}}
+	for _, child := range node.Children {
+		namespaces = append(namespaces, m.mapSimulatedNamespaces(child, node.Namespace.GetName())...)
+	}
+	return namespaces
+}
+
+func (m *MeridianGrpcHandler) mapQuotaRequest(request domain.QuotaRequest) *api.QuotaRequest {
+	mapped := &api.QuotaRequest{
+		Id:            request.GetId(),
+		Namespace:     request.GetNamespaceName(),
+		Requester:     request.GetRequester(),
+		Justification: request.GetJustification(),
+		Quotas:        m.resourceTypes.FormatResourceQuotas(request.GetOrgId(), request.GetResourceQuotas()),
+		CreatedAt:     request.GetCreatedAt().Unix(),
+		Status:        string(request.GetStatus()),
+		Reviewer:      request.GetReviewer(),
+		ReviewComment: request.GetReviewComment(),
+	}
+	if !request.IsPending() {
+		mapped.ReviewedAt = request.GetReviewedAt().Unix()
+	}
+	return mapped
+}
+
+func (m *MeridianGrpcHandler) mapScheduledQuotaChange(change domain.ScheduledQuotaChange) *api.ScheduledQuotaChange {
+	mapped := &api.ScheduledQuotaChange{
+		Id:        change.GetId(),
+		Namespace: change.GetNamespaceName(),
+		App:       change.GetAppName(),
+		Quotas:    m.resourceTypes.FormatResourceQuotas(change.GetOrgId(), change.GetResourceQuotas()),
+		Cron:      change.GetCron(),
+		Status:    string(change.GetStatus()),
+		LastError: change.GetLastError(),
+		Failures:  change.GetFailures(),
+	}
+	if !change.GetNextRunAt().IsZero() {
+		mapped.NextRunAt = change.GetNextRunAt().Unix()
+	}
+	if !change.GetLastRunAt().IsZero() {
+		mapped.LastRunAt = change.GetLastRunAt().Unix()
+	}
+	return mapped
+}
+
+func mapUtilizationThresholds(thresholds domain.UtilizationThresholds) map[string]*api.UtilizationThreshold {
+	mapped := make(map[string]*api.UtilizationThreshold)
+	for resource, threshold := range thresholds {
+		// stored in per mille
+		mapped[resource] = &api.UtilizationThreshold{
+			Warning:  float64(threshold.Warning) / 10,
+			Critical: float64(threshold.Critical) / 10,
+		}
+	}
+	return mapped
+}
+
+func mapAlertLevels(levels map[string]domain.AlertLevel) map[string]string {
+	mapped := make(map[string]string)
+	for resource, level := range levels {
+		mapped[resource] = string(level)
+	}
+	return mapped
+}
+
+func (m *MeridianGrpcHandler) mapLimitRange(orgId string, limitRange domain.LimitRange) *api.LimitRange {
+	mapped := &api.LimitRange{
+		Defaults: m.resourceTypes.FormatResourceQuotas(orgId, limitRange.Defaults),
+		Min:      m.resourceTypes.FormatResourceQuotas(orgId, limitRange.Min),
+		Max:      m.resourceTypes.FormatResourceQuotas(orgId, limitRange.Max),
+	}
+	for _, ratio := range limitRange.MaxRatios {
+		mapped.MaxRatios = append(mapped.MaxRatios, &api.LimitRange_Ratio{
+			Resource:    ratio.Resource,
+			PerResource: ratio.PerResource,
+			Max:         m.resourceTypes.FormatResourceQuotas(orgId, domain.ResourceQuotas{ratio.Resource: ratio.Max})[ratio.Resource],
+		})
+	}
+	return mapped
 }
 
 func selectRandmNodes(nodes []*magnetarapi.NodeStringified, percentage int32) []*magnetarapi.NodeStringified {
@@ -0,0 +1,923 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	gravityapi "github.com/c12s/gravity/pkg/api"
+	magnetarapi "github.com/c12s/magnetar/pkg/api"
+	"github.com/c12s/meridian/internal/domain"
+	"github.com/c12s/meridian/internal/store"
+	"github.com/c12s/meridian/internal/workers"
+	"github.com/c12s/meridian/pkg/api"
+	oortapi "github.com/c12s/oort/pkg/api"
+	pulsar_api "github.com/c12s/pulsar/model/protobuf"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testOrg    = "org"
+	testSecret = "secret"
+	// testAdmin can manage the quotas of all namespaces
+	testAdmin = "admin"
+	// testLead can manage the quotas of the namespaces below the lead namespace
+	testLead = "lead"
+)
+
+type fakePulsar struct {
+	pulsar_api.SeccompServiceClient
+}
+
+func (fakePulsar) DefineSeccompProfile(ctx context.Context, in *pulsar_api.SeccompProfileDefinitionRequest, opts ...grpc.CallOption) (*pulsar_api.SeccompProfileDefinitionResponse, error) {
+	return &pulsar_api.SeccompProfileDefinitionResponse{}, nil
+}
+
+func (fakePulsar) GetSeccompProfile(ctx context.Context, in *pulsar_api.SeccompProfile, opts ...grpc.CallOption) (*pulsar_api.GetSeccompProfileResponse, error) {
+	return nil, status.Error(codes.NotFound, "seccomp profile not found")
+}
+
+func (fakePulsar) DeleteSeccompProfile(ctx context.Context, in *pulsar_api.SeccompProfile, opts ...grpc.CallOption) (*pulsar_api.DeleteSeccompProfileResponse, error) {
+	return &pulsar_api.DeleteSeccompProfileResponse{}, nil
+}
+
+type fakeAdministrator struct{}
+
+func (fakeAdministrator) SendRequest(req any, callback func(resp *oortapi.AdministrationAsyncResp)) error {
+	return nil
+}
+
+type fakeEvaluator struct{}
+
+func (fakeEvaluator) Authorize(ctx context.Context, in *oortapi.AuthorizationReq, opts ...grpc.CallOption) (*oortapi.AuthorizationResp, error) {
+	if in.PermissionName != manageQuotasPermission {
+		return &oortapi.AuthorizationResp{}, nil
+	}
+	authorized := in.Subject.Id == testAdmin || in.Subject.Id == testLead && in.Object.Id == domain.MakeNamespaceId(testOrg, "lead")
+	return &oortapi.AuthorizationResp{Authorized: authorized}, nil
+}
+
+type fakeGravity struct {
+	gravityapi.AgentQueueClient
+}
+
+func (fakeGravity) DisseminateAppConfig(ctx context.Context, in *gravityapi.DeseminateConfigRequest, opts ...grpc.CallOption) (*gravityapi.DeseminateConfigResponse, error) {
+	return &gravityapi.DeseminateConfigResponse{}, nil
+}
+
+type fakeMagnetar struct {
+	magnetarapi.MagnetarClient
+}
+
+func (fakeMagnetar) ListOrgOwnedNodes(ctx context.Context, in *magnetarapi.ListOrgOwnedNodesReq, opts ...grpc.CallOption) (*magnetarapi.ListOrgOwnedNodesResp, error) {
+	return &magnetarapi.ListOrgOwnedNodesResp{Nodes: []*magnetarapi.NodeStringified{{Id: "node"}}}, nil
+}
+
+// fakePublisher keeps the published messages by subject.
+type fakePublisher struct {
+	mu       sync.Mutex
+	messages map[string][][]byte
+}
+
+func (p *fakePublisher) Publish(msg []byte, subject string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.messages == nil {
+		p.messages = make(map[string][][]byte)
+	}
+	p.messages[subject] = append(p.messages[subject], msg)
+	return nil
+}
+
+func (p *fakePublisher) Messages(subject string) [][]byte {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.messages[subject]
+}
+
+// newTestHandler returns a handler backed by the memory stores with the default resource types.
+func newTestHandler(t *testing.T) api.MeridianServer {
+	t.Helper()
+	return newTestHandlerWith(t, Auth{TokenSecret: []byte(testSecret)}, &fakePublisher{})
+}
+
+func newTestHandlerWith(t *testing.T, auth Auth, publisher *fakePublisher) api.MeridianServer {
+	t.Helper()
+	db := store.NewMemoryDb()
+	namespaces := store.NewNamespaceMemoryStore(db)
+	quotas := store.NewResourceQuotaMemoryStore(db)
+	apps := store.NewAppMemoryStore(db)
+	history := store.NewQuotaHistoryMemoryStore(db)
+	resourceTypes := store.NewResourceTypeMemoryStore(db)
+	registry := domain.NewResourceTypeRegistry(resourceTypes)
+	if err := registry.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return NewMeridianGrpcHandler(Stores{
+		Namespaces:    namespaces,
+		Apps:          apps,
+		Resources:     quotas,
+		ResourceTypes: resourceTypes,
+		Reservations:  store.NewReservationMemoryStore(db),
+		QuotaRequests: store.NewQuotaRequestMemoryStore(db),
+		Schedules:     store.NewScheduledQuotaChangeMemoryStore(db),
+		QuotaHistory:  history,
+		Prices:        store.NewResourcePriceMemoryStore(db),
+		TxManager:     store.NewMemoryTxManager(db),
+	}, Clients{
+		Pulsar:        fakePulsar{},
+		Administrator: fakeAdministrator{},
+		Gravity:       fakeGravity{},
+		Magnetar:      fakeMagnetar{},
+		Publisher:     publisher,
+		Evaluator:     fakeEvaluator{},
+	}, workers.Observers{
+		Alerter:  workers.NewUtilizationAlerter(publisher, namespaces),
+		Recorder: workers.NewQuotaHistoryRecorder(history, namespaces, apps),
+	}, registry, auth)
+}
+
+// userContext returns the context of a call made with the token of the user.
+func userContext(t *testing.T, user string) context.Context {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   user,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}).SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("SignedString() error = %v", err)
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(tokenMetadataKey, token))
+}
+
+func addTestNamespace(t *testing.T, handler api.MeridianServer, name, parent string, quotas map[string]string) {
+	t.Helper()
+	_, err := handler.AddNamespace(context.Background(), &api.AddNamespaceReq{
+		OrgId:                     testOrg,
+		Name:                      name,
+		ParentName:                parent,
+		QuotaQuantities:           quotas,
+		SeccompDefinitionStrategy: "redefine",
+		Profile:                   &api.SeccompProfile{Version: "v1"},
+	})
+	if err != nil {
+		t.Fatalf("AddNamespace(%s) error = %v", name, err)
+	}
+}
+
+func wantCode(t *testing.T, call string, err error, want codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != want {
+		t.Fatalf("%s error = %v, want code %v", call, err, want)
+	}
+}
+
+func TestSetNamespaceOvercommit(t *testing.T) {
+	ctx := userContext(t, testAdmin)
+	handler := newTestHandler(t)
+	addTestNamespace(t, handler, "a", "", map[string]string{"cpu": "10"})
+	addTestNamespace(t, handler, "b", "a", map[string]string{"cpu": "4"})
+
+	_, err := handler.SetNamespaceOvercommit(ctx, &api.SetNamespaceOvercommitReq{OrgId: testOrg, Name: "a", Overcommit: map[string]float64{"cpu": 0.5}})
+	wantCode(t, "SetNamespaceOvercommit(0.5)", err, codes.InvalidArgument)
+	_, err = handler.SetNamespaceOvercommit(ctx, &api.SetNamespaceOvercommitReq{OrgId: testOrg, Name: "missing", Overcommit: map[string]float64{"cpu": 2}})
+	wantCode(t, "SetNamespaceOvercommit(missing)", err, codes.NotFound)
+	_, err = handler.SetNamespaceOvercommit(ctx, &api.SetNamespaceOvercommitReq{OrgId: testOrg, Name: "a", Overcommit: map[string]float64{"cpu": 2}})
+	wantCode(t, "SetNamespaceOvercommit(2)", err, codes.OK)
+
+	resp, err := handler.GetNamespace(ctx, &api.GetNamespaceReq{OrgId: testOrg, Name: "a"})
+	if err != nil {
+		t.Fatalf("GetNamespace() error = %v", err)
+	}
+	if got := resp.Overcommit["cpu"]; got != 2 {
+		t.Errorf("overcommit = %v, want 2", got)
+	}
+	if got := resp.TotalQuantities["cpu"]; got != "10" {
+		t.Errorf("total = %q, want 10", got)
+	}
+	if got := resp.Effective["cpu"]; got != "20" {
+		t.Errorf("effective = %q, want 20", got)
+	}
+
+	// the child can have the effective capacity less what its sibling use, which is more than the nominal one
+	_, err = handler.SetNamespaceResources(ctx, &api.SetNamespaceResourcesReq{OrgId: testOrg, Name: "b", QuotaQuantities: map[string]string{"cpu": "20"}})
+	wantCode(t, "SetNamespaceResources(20)", err, codes.OK)
+	addTestNamespace(t, handler, "c", "a", nil)
+	_, err = handler.SetNamespaceResources(ctx, &api.SetNamespaceResourcesReq{OrgId: testOrg, Name: "c", QuotaQuantities: map[string]string{"cpu": "1"}})
+	if err == nil {
+		t.Errorf("SetNamespaceResources() of a child beyond the effective capacity succeeded")
+	}
+	_, err = handler.SetNamespaceOvercommit(ctx, &api.SetNamespaceOvercommitReq{OrgId: testOrg, Name: "a", Overcommit: map[string]float64{"cpu": 1}})
+	wantCode(t, "SetNamespaceOvercommit(1) below the utilization", err, codes.FailedPrecondition)
+}
+
+func TestSetNamespaceLimitRange(t *testing.T) {
+	ctx := userContext(t, testAdmin)
+	handler := newTestHandler(t)
+	addTestNamespace(t, handler, "a", "", map[string]string{"cpu": "10", "mem": "10Gi"})
+
+	_, err := handler.SetNamespaceLimitRange(ctx, &api.SetNamespaceLimitRangeReq{OrgId: testOrg, Name: "a", LimitRange: &api.LimitRange{
+		Defaults: map[string]string{"cpu": "4"},
+		Max:      map[string]string{"cpu": "2"},
+	}})
+	wantCode(t, "SetNamespaceLimitRange(defaults above max)", err, codes.InvalidArgument)
+	_, err = handler.SetNamespaceLimitRange(ctx, &api.SetNamespaceLimitRangeReq{OrgId: testOrg, Name: "a", LimitRange: &api.LimitRange{
+		Defaults:  map[string]string{"cpu": "1", "mem": "1Gi"},
+		Min:       map[string]string{"cpu": "500m"},
+		Max:       map[string]string{"cpu": "2"},
+		MaxRatios: []*api.LimitRange_Ratio{{Resource: "mem", PerResource: "cpu", Max: "2Gi"}},
+	}})
+	wantCode(t, "SetNamespaceLimitRange()", err, codes.OK)
+
+	tests := []struct {
+		name   string
+		quotas map[string]string
+		want   codes.Code
+	}{
+		{name: "defaults", want: codes.OK},
+		{name: "below_min", quotas: map[string]string{"cpu": "100m"}, want: codes.InvalidArgument},
+		{name: "above_max", quotas: map[string]string{"cpu": "3"}, want: codes.InvalidArgument},
+		{name: "above_ratio", quotas: map[string]string{"cpu": "1", "mem": "3Gi"}, want: codes.InvalidArgument},
+		{name: "within", quotas: map[string]string{"cpu": "2", "mem": "4Gi"}, want: codes.OK},
+	}
+	for _, tt := range tests {
+		_, err := handler.AddApp(ctx, &api.AddAppReq{
+			OrgId:                     testOrg,
+			Namespace:                 "a",
+			Name:                      tt.name,
+			QuotaQuantities:           tt.quotas,
+			SeccompDefinitionStrategy: "redefine",
+			Profile:                   &api.SeccompProfile{Version: "v1"},
+		})
+		wantCode(t, "AddApp("+tt.name+")", err, tt.want)
+	}
+
+	resp, err := handler.GetApp(ctx, &api.GetAppReq{OrgId: testOrg, Namespace: "a", Name: "defaults"})
+	if err != nil {
+		t.Fatalf("GetApp() error = %v", err)
+	}
+	if got := resp.TotalQuantities["cpu"]; got != "1" {
+		t.Errorf("default cpu = %q, want 1", got)
+	}
+	if got := resp.TotalQuantities["mem"]; got != "1Gi" {
+		t.Errorf("default mem = %q, want 1Gi", got)
+	}
+}
+
+func TestSetNamespaceCountQuotas(t *testing.T) {
+	ctx := userContext(t, testAdmin)
+	handler := newTestHandler(t)
+	addTestNamespace(t, handler, "a", "", nil)
+	addTestNamespace(t, handler, "b", "a", nil)
+	addTestNamespace(t, handler, "c", "b", nil)
+
+	_, err := handler.SetNamespaceCountQuotas(ctx, &api.SetNamespaceCountQuotasReq{OrgId: testOrg, Name: "a", MaxNamespaces: -1})
+	wantCode(t, "SetNamespaceCountQuotas(-1)", err, codes.InvalidArgument)
+	_, err = handler.SetNamespaceCountQuotas(ctx, &api.SetNamespaceCountQuotasReq{OrgId: testOrg, Name: "a", MaxNamespaces: 1})
+	wantCode(t, "SetNamespaceCountQuotas(below the count)", err, codes.InvalidArgument)
+	_, err = handler.SetNamespaceCountQuotas(ctx, &api.SetNamespaceCountQuotasReq{OrgId: testOrg, Name: "a", MaxApps: 1, MaxNamespaces: 2})
+	wantCode(t, "SetNamespaceCountQuotas()", err, codes.OK)
+
+	_, err = handler.AddNamespace(ctx, &api.AddNamespaceReq{OrgId: testOrg, Name: "d", ParentName: "c", SeccompDefinitionStrategy: "redefine", Profile: &api.SeccompProfile{Version: "v1"}})
+	wantCode(t, "AddNamespace() beyond the count quota of an ancestor", err, codes.InvalidArgument)
+	addTestNamespace(t, handler, "d", "", nil)
+	_, err = handler.MoveNamespace(ctx, &api.MoveNamespaceReq{OrgId: testOrg, Name: "d", ParentName: "b"})
+	wantCode(t, "MoveNamespace() beyond the count quota", err, codes.InvalidArgument)
+	// moving within the subtree does not change its counts
+	_, err = handler.MoveNamespace(ctx, &api.MoveNamespaceReq{OrgId: testOrg, Name: "c", ParentName: "a"})
+	wantCode(t, "MoveNamespace() within the subtree", err, codes.OK)
+
+	for i, name := range []string{"x", "y"} {
+		_, err := handler.AddApp(ctx, &api.AddAppReq{
+			OrgId:                     testOrg,
+			Namespace:                 "b",
+			Name:                      name,
+			SeccompDefinitionStrategy: "redefine",
+			Profile:                   &api.SeccompProfile{Version: "v1"},
+		})
+		want := codes.OK
+		if i > 0 {
+			want = codes.InvalidArgument
+		}
+		wantCode(t, "AddApp("+name+")", err, want)
+	}
+
+	resp, err := handler.GetNamespace(ctx, &api.GetNamespaceReq{OrgId: testOrg, Name: "a"})
+	if err != nil {
+		t.Fatalf("GetNamespace() error = %v", err)
+	}
+	if resp.Counts.Apps != 1 || resp.Counts.Namespaces != 2 {
+		t.Errorf("counts = %v, want 1 app and 2 namespaces", resp.Counts)
+	}
+	if resp.CountQuotas.Apps != 1 || resp.CountQuotas.Namespaces != 2 {
+		t.Errorf("count quotas = %v, want 1 app and 2 namespaces", resp.CountQuotas)
+	}
+}
+
+func TestSetNamespaceElasticQuotas(t *testing.T) {
+	ctx := userContext(t, testAdmin)
+	handler := newTestHandler(t)
+	addTestNamespace(t, handler, "root", "", map[string]string{"cpu": "10"})
+	addTestNamespace(t, handler, "a", "root", map[string]string{"cpu": "4"})
+	addTestNamespace(t, handler, "b", "root", map[string]string{"cpu": "4"})
+
+	_, err := handler.SetNamespaceElasticQuotas(ctx, &api.SetNamespaceElasticQuotasReq{OrgId: testOrg, Name: "missing", Max: map[string]string{"cpu": "6"}})
+	wantCode(t, "SetNamespaceElasticQuotas(missing)", err, codes.NotFound)
+	_, err = handler.SetNamespaceElasticQuotas(ctx, &api.SetNamespaceElasticQuotasReq{OrgId: testOrg, Name: "a", Max: map[string]string{"gpu": "6"}})
+	wantCode(t, "SetNamespaceElasticQuotas(gpu)", err, codes.InvalidArgument)
+	_, err = handler.SetNamespaceElasticQuotas(ctx, &api.SetNamespaceElasticQuotasReq{OrgId: testOrg, Name: "a", Max: map[string]string{"cpu": "6"}})
+	wantCode(t, "SetNamespaceElasticQuotas()", err, codes.OK)
+
+	addTestNamespace(t, handler, "a1", "a", map[string]string{"cpu": "6"})
+	_, err = handler.AddNamespace(ctx, &api.AddNamespaceReq{OrgId: testOrg, Name: "a2", ParentName: "a", QuotaQuantities: map[string]string{"cpu": "1"}, SeccompDefinitionStrategy: "redefine", Profile: &api.SeccompProfile{Version: "v1"}})
+	if err == nil {
+		t.Errorf("AddNamespace() beyond the elastic max succeeded")
+	}
+	resp, err := handler.GetNamespace(ctx, &api.GetNamespaceReq{OrgId: testOrg, Name: "a"})
+	if err != nil {
+		t.Fatalf("GetNamespace() error = %v", err)
+	}
+	if got := resp.Borrowed["cpu"]; got != "2" {
+		t.Errorf("borrowed = %q, want 2", got)
+	}
+	if got := resp.ElasticMax["cpu"]; got != "6" {
+		t.Errorf("elastic max = %q, want 6", got)
+	}
+
+	// the lender can reclaim its unused quota
+	addTestNamespace(t, handler, "b1", "b", map[string]string{"cpu": "4"})
+	_, err = handler.SetNamespaceElasticQuotas(ctx, &api.SetNamespaceElasticQuotasReq{OrgId: testOrg, Name: "a", Max: map[string]string{"cpu": "5"}})
+	wantCode(t, "SetNamespaceElasticQuotas() below the utilization", err, codes.FailedPrecondition)
+}
+
+func TestTransferQuota(t *testing.T) {
+	ctx := userContext(t, testAdmin)
+	handler := newTestHandler(t)
+	addTestNamespace(t, handler, "root", "", map[string]string{"cpu": "10"})
+	addTestNamespace(t, handler, "a", "root", map[string]string{"cpu": "4"})
+	addTestNamespace(t, handler, "b", "root", map[string]string{"cpu": "4"})
+	addTestNamespace(t, handler, "other", "", map[string]string{"cpu": "4"})
+	_, err := handler.AddApp(ctx, &api.AddAppReq{
+		OrgId:                     testOrg,
+		Namespace:                 "a",
+		Name:                      "app",
+		QuotaQuantities:           map[string]string{"cpu": "3"},
+		SeccompDefinitionStrategy: "redefine",
+		Profile:                   &api.SeccompProfile{Version: "v1"},
+	})
+	wantCode(t, "AddApp()", err, codes.OK)
+
+	tests := []struct {
+		name   string
+		from   *api.QuotaHolder
+		to     *api.QuotaHolder
+		quotas map[string]string
+		want   codes.Code
+	}{
+		{name: "no_common_ancestor", from: &api.QuotaHolder{Namespace: "a"}, to: &api.QuotaHolder{Namespace: "other"}, quotas: map[string]string{"cpu": "1"}, want: codes.InvalidArgument},
+		{name: "same", from: &api.QuotaHolder{Namespace: "a"}, to: &api.QuotaHolder{Namespace: "a"}, quotas: map[string]string{"cpu": "1"}, want: codes.InvalidArgument},
+		{name: "missing_app", from: &api.QuotaHolder{Namespace: "a", App: "missing"}, to: &api.QuotaHolder{Namespace: "b"}, quotas: map[string]string{"cpu": "1"}, want: codes.NotFound},
+		{name: "above_the_quota", from: &api.QuotaHolder{Namespace: "b"}, to: &api.QuotaHolder{Namespace: "a"}, quotas: map[string]string{"cpu": "5"}, want: codes.InvalidArgument},
+		// a keeps only 2 of its quota, but its app already has 3
+		{name: "below_the_utilization", from: &api.QuotaHolder{Namespace: "a"}, to: &api.QuotaHolder{Namespace: "b"}, quotas: map[string]string{"cpu": "2"}, want: codes.FailedPrecondition},
+		{name: "siblings", from: &api.QuotaHolder{Namespace: "b"}, to: &api.QuotaHolder{Namespace: "a"}, quotas: map[string]string{"cpu": "2"}, want: codes.OK},
+		{name: "app_from_ancestor", from: &api.QuotaHolder{Namespace: "a", App: "app"}, to: &api.QuotaHolder{Namespace: "root"}, quotas: map[string]string{"cpu": "1"}, want: codes.OK},
+	}
+	for _, tt := range tests {
+		_, err := handler.TransferQuota(ctx, &api.TransferQuotaReq{OrgId: testOrg, From: tt.from, To: tt.to, Quotas: tt.quotas})
+		wantCode(t, "TransferQuota("+tt.name+")", err, tt.want)
+	}
+
+	for name, want := range map[string]string{"a": "6", "b": "2"} {
+		resp, err := handler.GetNamespace(ctx, &api.GetNamespaceReq{OrgId: testOrg, Name: name})
+		if err != nil {
+			t.Fatalf("GetNamespace(%s) error = %v", name, err)
+		}
+		if got := resp.TotalQuantities["cpu"]; got != want {
+			t.Errorf("%s total = %q, want %s", name, got, want)
+		}
+	}
+	app, err := handler.GetApp(ctx, &api.GetAppReq{OrgId: testOrg, Namespace: "a", Name: "app"})
+	if err != nil {
+		t.Fatalf("GetApp() error = %v", err)
+	}
+	if got := app.TotalQuantities["cpu"]; got != "2" {
+		t.Errorf("app total = %q, want 2", got)
+	}
+}
+
+func TestReserveQuota(t *testing.T) {
+	ctx := userContext(t, testAdmin)
+	handler := newTestHandler(t)
+	addTestNamespace(t, handler, "a", "", map[string]string{"cpu": "4", "mem": "4Gi"})
+
+	_, err := handler.ReserveQuota(ctx, &api.ReserveQuotaReq{OrgId: testOrg, Namespace: "a", Name: "r", Quotas: map[string]string{"cpu": "3"}})
+	wantCode(t, "ReserveQuota() without a ttl", err, codes.InvalidArgument)
+	_, err = handler.ReserveQuota(ctx, &api.ReserveQuotaReq{OrgId: testOrg, Namespace: "a", Name: "r", Quotas: map[string]string{"cpu": "3"}, TtlSeconds: 60})
+	wantCode(t, "ReserveQuota()", err, codes.OK)
+	_, err = handler.ReserveQuota(ctx, &api.ReserveQuotaReq{OrgId: testOrg, Namespace: "a", Name: "r", Quotas: map[string]string{"cpu": "1"}, TtlSeconds: 60})
+	wantCode(t, "ReserveQuota() twice", err, codes.AlreadyExists)
+	_, err = handler.ReserveQuota(ctx, &api.ReserveQuotaReq{OrgId: testOrg, Namespace: "a", Name: "s", Quotas: map[string]string{"cpu": "2"}, TtlSeconds: 60})
+	if err == nil {
+		t.Errorf("ReserveQuota() beyond the available quota succeeded")
+	}
+
+	// the reserved quotas are only available to the app the reservation is converted into
+	addApp := func(name, reservation string) error {
+		_, err := handler.AddApp(ctx, &api.AddAppReq{
+			OrgId:                     testOrg,
+			Namespace:                 "a",
+			Name:                      name,
+			Reservation:               reservation,
+			SeccompDefinitionStrategy: "redefine",
+			Profile:                   &api.SeccompProfile{Version: "v1"},
+			QuotaQuantities:           map[string]string{"mem": "1Gi"},
+		})
+		return err
+	}
+	if err := addApp("other", ""); err != nil {
+		t.Fatalf("AddApp(other) error = %v", err)
+	}
+	_, err = handler.SetAppResources(ctx, &api.SetAppResourcesReq{OrgId: testOrg, Namespace: "a", Name: "other", QuotaQuantities: map[string]string{"cpu": "2"}})
+	if err == nil {
+		t.Errorf("SetAppResources() of reserved quotas succeeded")
+	}
+	wantCode(t, "AddApp(missing reservation)", addApp("app", "missing"), codes.NotFound)
+	wantCode(t, "AddApp(reservation)", addApp("app", "r"), codes.OK)
+
+	app, err := handler.GetApp(ctx, &api.GetAppReq{OrgId: testOrg, Namespace: "a", Name: "app"})
+	if err != nil {
+		t.Fatalf("GetApp() error = %v", err)
+	}
+	if got := app.TotalQuantities["cpu"]; got != "3" {
+		t.Errorf("app cpu = %q, want the reserved 3", got)
+	}
+	list, err := handler.ListReservations(ctx, &api.ListReservationsReq{OrgId: testOrg, Namespace: "a"})
+	if err != nil {
+		t.Fatalf("ListReservations() error = %v", err)
+	}
+	if len(list.Reservations) != 0 {
+		t.Errorf("reservations = %v, want none after the conversion", list.Reservations)
+	}
+	_, err = handler.CancelReservation(ctx, &api.CancelReservationReq{OrgId: testOrg, Namespace: "a", Name: "r"})
+	wantCode(t, "CancelReservation() of a converted reservation", err, codes.NotFound)
+}
+
+func TestQuotaRequests(t *testing.T) {
+	handler := newTestHandler(t)
+	lead, dev := userContext(t, testLead), userContext(t, "dev")
+	addTestNamespace(t, handler, "lead", "", map[string]string{"cpu": "10"})
+	addTestNamespace(t, handler, "team", "lead", map[string]string{"cpu": "2"})
+
+	setResources := func(ctx context.Context, name, cpu string) error {
+		_, err := handler.SetNamespaceResources(ctx, &api.SetNamespaceResourcesReq{OrgId: testOrg, Name: name, QuotaQuantities: map[string]string{"cpu": cpu}})
+		return err
+	}
+	wantCode(t, "SetNamespaceResources() without a token", setResources(context.Background(), "team", "5"), codes.Unauthenticated)
+	forged := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tokenMetadataKey, "forged"))
+	wantCode(t, "SetNamespaceResources() with a forged token", setResources(forged, "team", "5"), codes.Unauthenticated)
+	wantCode(t, "SetNamespaceResources() by the team", setResources(dev, "team", "5"), codes.PermissionDenied)
+	wantCode(t, "SetNamespaceResources() of the own namespace", setResources(lead, "lead", "20"), codes.PermissionDenied)
+	wantCode(t, "SetNamespaceResources() by the lead", setResources(lead, "team", "3"), codes.OK)
+
+	create := func(cpu string) *api.QuotaRequest {
+		resp, err := handler.CreateQuotaRequest(dev, &api.CreateQuotaRequestReq{OrgId: testOrg, Namespace: "team", Justification: "batch jobs", Quotas: map[string]string{"cpu": cpu}})
+		if err != nil {
+			t.Fatalf("CreateQuotaRequest() error = %v", err)
+		}
+		return resp.Request
+	}
+	_, err := handler.CreateQuotaRequest(context.Background(), &api.CreateQuotaRequestReq{OrgId: testOrg, Namespace: "team", Quotas: map[string]string{"cpu": "5"}})
+	wantCode(t, "CreateQuotaRequest() without a token", err, codes.Unauthenticated)
+
+	approved := create("5")
+	if approved.Requester != "dev" || approved.Status != "pending" {
+		t.Errorf("request = %v, want a pending request of dev", approved)
+	}
+	_, err = handler.ApproveQuotaRequest(dev, &api.ApproveQuotaRequestReq{OrgId: testOrg, Id: approved.Id})
+	wantCode(t, "ApproveQuotaRequest() by the requester", err, codes.PermissionDenied)
+	resp, err := handler.ApproveQuotaRequest(lead, &api.ApproveQuotaRequestReq{OrgId: testOrg, Id: approved.Id, Comment: "ok"})
+	if err != nil {
+		t.Fatalf("ApproveQuotaRequest() error = %v", err)
+	}
+	if resp.Request.Status != "approved" || resp.Request.Reviewer != testLead {
+		t.Errorf("request = %v, want approved by %s", resp.Request, testLead)
+	}
+	_, err = handler.RejectQuotaRequest(lead, &api.RejectQuotaRequestReq{OrgId: testOrg, Id: approved.Id})
+	wantCode(t, "RejectQuotaRequest() of a reviewed request", err, codes.FailedPrecondition)
+
+	// the approval goes through the same checks as setting the quotas directly
+	tooLarge := create("20")
+	_, err = handler.ApproveQuotaRequest(lead, &api.ApproveQuotaRequestReq{OrgId: testOrg, Id: tooLarge.Id})
+	wantCode(t, "ApproveQuotaRequest() beyond the parent quota", err, codes.FailedPrecondition)
+	_, err = handler.RejectQuotaRequest(lead, &api.RejectQuotaRequestReq{OrgId: testOrg, Id: tooLarge.Id, Comment: "too much"})
+	wantCode(t, "RejectQuotaRequest()", err, codes.OK)
+
+	namespace, err := handler.GetNamespace(lead, &api.GetNamespaceReq{OrgId: testOrg, Name: "team"})
+	if err != nil {
+		t.Fatalf("GetNamespace() error = %v", err)
+	}
+	if got := namespace.TotalQuantities["cpu"]; got != "5" {
+		t.Errorf("total = %q, want the approved 5", got)
+	}
+	list, err := handler.ListQuotaRequests(lead, &api.ListQuotaRequestsReq{OrgId: testOrg, Namespace: "team"})
+	if err != nil {
+		t.Fatalf("ListQuotaRequests() error = %v", err)
+	}
+	if len(list.Requests) != 2 || list.Requests[0].Status != "approved" || list.Requests[1].Status != "rejected" {
+		t.Errorf("requests = %v, want the approved and the rejected one", list.Requests)
+	}
+}
+
+func TestOpenQuotaChanges(t *testing.T) {
+	handler := newTestHandlerWith(t, Auth{TokenSecret: []byte(testSecret), OpenQuotaChanges: true}, &fakePublisher{})
+	addTestNamespace(t, handler, "a", "", map[string]string{"cpu": "10"})
+
+	_, err := handler.SetNamespaceResources(context.Background(), &api.SetNamespaceResourcesReq{OrgId: testOrg, Name: "a", QuotaQuantities: map[string]string{"cpu": "20"}})
+	wantCode(t, "SetNamespaceResources() without a token", err, codes.OK)
+	request, err := handler.CreateQuotaRequest(userContext(t, "dev"), &api.CreateQuotaRequestReq{OrgId: testOrg, Namespace: "a", Quotas: map[string]string{"cpu": "30"}})
+	if err != nil {
+		t.Fatalf("CreateQuotaRequest() error = %v", err)
+	}
+	_, err = handler.ApproveQuotaRequest(userContext(t, "dev"), &api.ApproveQuotaRequestReq{OrgId: testOrg, Id: request.Request.Id})
+	wantCode(t, "ApproveQuotaRequest() by the requester", err, codes.PermissionDenied)
+}
+
+func TestScheduleQuotaChange(t *testing.T) {
+	handler := newTestHandler(t)
+	lead, dev := userContext(t, testLead), userContext(t, "dev")
+	addTestNamespace(t, handler, "lead", "", map[string]string{"cpu": "10"})
+	addTestNamespace(t, handler, "team", "lead", map[string]string{"cpu": "2"})
+
+	schedule := func(ctx context.Context, cron string) (*api.ScheduleQuotaChangeResp, error) {
+		return handler.ScheduleQuotaChange(ctx, &api.ScheduleQuotaChangeReq{OrgId: testOrg, Namespace: "team", Quotas: map[string]string{"cpu": "4"}, Cron: cron})
+	}
+	_, err := schedule(dev, "0 22 * * 1-5")
+	wantCode(t, "ScheduleQuotaChange() by the team", err, codes.PermissionDenied)
+	_, err = schedule(lead, "0 22 * *")
+	wantCode(t, "ScheduleQuotaChange() with an invalid cron", err, codes.InvalidArgument)
+	resp, err := schedule(lead, "0 22 * * 1-5")
+	if err != nil {
+		t.Fatalf("ScheduleQuotaChange() error = %v", err)
+	}
+	if resp.Change.Status != "scheduled" || resp.Change.NextRunAt <= time.Now().Unix() {
+		t.Errorf("change = %v, want one scheduled for later", resp.Change)
+	}
+
+	_, err = handler.CancelScheduledQuotaChange(dev, &api.CancelScheduledQuotaChangeReq{OrgId: testOrg, Id: resp.Change.Id})
+	wantCode(t, "CancelScheduledQuotaChange() by the team", err, codes.PermissionDenied)
+	_, err = handler.CancelScheduledQuotaChange(lead, &api.CancelScheduledQuotaChangeReq{OrgId: testOrg, Id: resp.Change.Id})
+	wantCode(t, "CancelScheduledQuotaChange() by the lead", err, codes.OK)
+	list, err := handler.ListScheduledQuotaChanges(lead, &api.ListScheduledQuotaChangesReq{OrgId: testOrg})
+	if err != nil {
+		t.Fatalf("ListScheduledQuotaChanges() error = %v", err)
+	}
+	if len(list.Changes) != 0 {
+		t.Errorf("changes = %v, want none after the cancellation", list.Changes)
+	}
+}
+
+func TestSetNamespaceUtilizationThresholds(t *testing.T) {
+	publisher := &fakePublisher{}
+	handler := newTestHandlerWith(t, Auth{TokenSecret: []byte(testSecret)}, publisher)
+	ctx := userContext(t, testAdmin)
+	addTestNamespace(t, handler, "a", "", map[string]string{"cpu": "10"})
+	addTestNamespace(t, handler, "b", "a", map[string]string{"cpu": "2"})
+
+	setThresholds := func(warning, critical float64) error {
+		_, err := handler.SetNamespaceUtilizationThresholds(ctx, &api.SetNamespaceUtilizationThresholdsReq{
+			OrgId:      testOrg,
+			Name:       "a",
+			Thresholds: map[string]*api.UtilizationThreshold{"cpu": {Warning: warning, Critical: critical}},
+		})
+		return err
+	}
+	wantCode(t, "SetNamespaceUtilizationThresholds() with warning above critical", setThresholds(90, 80), codes.InvalidArgument)
+	wantCode(t, "SetNamespaceUtilizationThresholds()", setThresholds(50, 90), codes.OK)
+
+	setResources := func(cpu string) {
+		_, err := handler.SetNamespaceResources(ctx, &api.SetNamespaceResourcesReq{OrgId: testOrg, Name: "b", QuotaQuantities: map[string]string{"cpu": cpu}})
+		if err != nil {
+			t.Fatalf("SetNamespaceResources() error = %v", err)
+		}
+	}
+	// only the levels that change are published
+	setResources("6")
+	setResources("7")
+	setResources("9")
+	setResources("1")
+	var levels []string
+	for _, msg := range publisher.Messages(api.UtilizationAlertSubject(testOrg)) {
+		event := &api.UtilizationAlertEvent{}
+		if err := proto.Unmarshal(msg, event); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		if event.NamespaceName != "a" || event.Resource != "cpu" {
+			t.Errorf("event = %v, want one of the cpu of a", event)
+		}
+		levels = append(levels, event.PreviousLevel+"->"+event.Level)
+	}
+	want := []string{"ok->warning", "warning->critical", "critical->ok"}
+	if !slices.Equal(levels, want) {
+		t.Errorf("alerts = %v, want %v", levels, want)
+	}
+
+	namespace, err := handler.GetNamespace(ctx, &api.GetNamespaceReq{OrgId: testOrg, Name: "a"})
+	if err != nil {
+		t.Fatalf("GetNamespace() error = %v", err)
+	}
+	if got := namespace.Thresholds["cpu"]; got.GetWarning() != 50 || got.GetCritical() != 90 || namespace.AlertLevels["cpu"] != "ok" {
+		t.Errorf("thresholds = %v, levels = %v, want 50 and 90 at ok", namespace.Thresholds, namespace.AlertLevels)
+	}
+}
+
+func TestGetQuotaHistory(t *testing.T) {
+	handler := newTestHandler(t)
+	ctx := userContext(t, testAdmin)
+	addTestNamespace(t, handler, "a", "", map[string]string{"cpu": "10"})
+	addTestNamespace(t, handler, "b", "a", map[string]string{"cpu": "2"})
+	_, err := handler.SetNamespaceResources(ctx, &api.SetNamespaceResourcesReq{OrgId: testOrg, Name: "b", QuotaQuantities: map[string]string{"cpu": "4"}})
+	if err != nil {
+		t.Fatalf("SetNamespaceResources() error = %v", err)
+	}
+	_, err = handler.RemoveNamespace(ctx, &api.RemoveNamespaceReq{OrgId: testOrg, Name: "b"})
+	if err != nil {
+		t.Fatalf("RemoveNamespace() error = %v", err)
+	}
+
+	history := func(namespace, resolution string) []*api.QuotaHistoryPoint {
+		t.Helper()
+		resp, err := handler.GetQuotaHistory(ctx, &api.GetQuotaHistoryReq{OrgId: testOrg, Namespace: namespace, Resources: []string{"cpu"}, Resolution: resolution})
+		if err != nil {
+			t.Fatalf("GetQuotaHistory(%s) error = %v", namespace, err)
+		}
+		if len(resp.Series) != 1 || resp.Series[0].Resource != "cpu" {
+			t.Fatalf("series = %v, want the cpu series", resp.Series)
+		}
+		return resp.Series[0].Points
+	}
+	// removed namespaces keep their history, which ends with a zero quota
+	var allocated []string
+	for _, point := range history("b", "raw") {
+		allocated = append(allocated, point.Allocated)
+	}
+	if want := []string{"2", "4", "0"}; !slices.Equal(allocated, want) {
+		t.Errorf("allocated = %v, want %v", allocated, want)
+	}
+	var utilized []string
+	for _, point := range history("a", "") {
+		utilized = append(utilized, point.Utilized)
+	}
+	if want := []string{"0", "2", "4", "0"}; !slices.Equal(utilized, want) {
+		t.Errorf("utilized = %v, want %v", utilized, want)
+	}
+	daily := history("a", "daily")
+	if last := daily[len(daily)-1]; last.Allocated != "10" || last.Utilized != "0" || last.Available != "10" {
+		t.Errorf("last daily point = %v, want the state after the removal", last)
+	}
+
+	_, err = handler.GetQuotaHistory(ctx, &api.GetQuotaHistoryReq{OrgId: testOrg, Namespace: "a", Resolution: "weekly"})
+	wantCode(t, "GetQuotaHistory() with an unknown resolution", err, codes.InvalidArgument)
+	_, err = handler.GetQuotaHistory(ctx, &api.GetQuotaHistoryReq{OrgId: testOrg, Namespace: "a", From: 2, To: 1})
+	wantCode(t, "GetQuotaHistory() with from after to", err, codes.InvalidArgument)
+}
+
+func TestGetCostReport(t *testing.T) {
+	handler := newTestHandler(t)
+	ctx := userContext(t, testAdmin)
+	addTestNamespace(t, handler, "a", "", map[string]string{"cpu": "10", "mem": "1Gi"})
+	addTestNamespace(t, handler, "b", "a", map[string]string{"cpu": "4"})
+	_, err := handler.PutResourcePrice(ctx, &api.PutResourcePriceReq{Price: &api.ResourcePrice{OrgId: testOrg, Resource: "gpu", PerHour: "1"}})
+	wantCode(t, "PutResourcePrice() of an unknown resource", err, codes.InvalidArgument)
+	_, err = handler.PutResourcePrice(ctx, &api.PutResourcePriceReq{Price: &api.ResourcePrice{OrgId: testOrg, Resource: "cpu", PerHour: "0.04"}})
+	if err != nil {
+		t.Fatalf("PutResourcePrice() error = %v", err)
+	}
+
+	// both namespaces hold their quotas for the whole range
+	from := time.Now().Add(time.Second).Unix()
+	resp, err := handler.GetCostReport(ctx, &api.GetCostReportReq{OrgId: testOrg, Namespace: "a", From: from, To: from + 2*3600, Format: "csv"})
+	if err != nil {
+		t.Fatalf("GetCostReport() error = %v", err)
+	}
+	if len(resp.Namespaces) != 2 {
+		t.Fatalf("namespaces = %v, want a and b", resp.Namespaces)
+	}
+	// a keeps the 6 cpus it does not hand out to b
+	a, b := resp.Namespaces[0], resp.Namespaces[1]
+	if a.UnitHours["cpu"] != "12.000" || a.Cost != "0.48" || a.SubtreeCost != "0.80" {
+		t.Errorf("a = %v, want 12 cpu-hours for 0.48 and 0.80 with b", a)
+	}
+	if b.Parent != "a" || b.Cost != "0.32" {
+		t.Errorf("b = %v, want 0.32 under a", b)
+	}
+	if resp.Total != "0.80" || !slices.Equal(resp.Unpriced, []string{"mem"}) {
+		t.Errorf("total = %s, unpriced = %v, want 0.80 with mem unpriced", resp.Total, resp.Unpriced)
+	}
+	if len(resp.Document) == 0 {
+		t.Errorf("document is empty, want the csv report")
+	}
+}
+
+func TestGetUtilizationReport(t *testing.T) {
+	handler := newTestHandler(t)
+	ctx := userContext(t, testAdmin)
+	addTestNamespace(t, handler, "a", "", map[string]string{"cpu": "10", "mem": "1Gi"})
+	addTestNamespace(t, handler, "b", "a", map[string]string{"cpu": "8", "mem": "100Mi"})
+	addTestNamespace(t, handler, "c", "a", map[string]string{"cpu": "1"})
+	for _, app := range []struct {
+		namespace, name, cpu string
+	}{{"b", "x", "6"}, {"c", "y", "1"}, {"b", "z", "1"}} {
+		_, err := handler.AddApp(ctx, &api.AddAppReq{
+			OrgId:                     testOrg,
+			Namespace:                 app.namespace,
+			Name:                      app.name,
+			QuotaQuantities:           map[string]string{"cpu": app.cpu},
+			SeccompDefinitionStrategy: "redefine",
+			Profile:                   &api.SeccompProfile{Version: "v1"},
+		})
+		if err != nil {
+			t.Fatalf("AddApp(%s) error = %v", app.name, err)
+		}
+	}
+
+	_, err := handler.GetUtilizationReport(ctx, &api.GetUtilizationReportReq{OrgId: testOrg, Namespace: "a", LowUtilization: 50, HighUtilization: 40})
+	wantCode(t, "GetUtilizationReport() with low above high", err, codes.InvalidArgument)
+	resp, err := handler.GetUtilizationReport(ctx, &api.GetUtilizationReportReq{OrgId: testOrg, Namespace: "a", Top: 1})
+	if err != nil {
+		t.Fatalf("GetUtilizationReport() error = %v", err)
+	}
+	flags := make(map[string][]string)
+	for _, namespace := range resp.Namespaces {
+		flags[namespace.Name] = append(slices.Clone(namespace.OverProvisioned), namespace.NearExhaustion...)
+	}
+	// a hands out 90% of its cpu and 10% of its mem, b and c have apps without mem quotas
+	want := map[string][]string{"a": {"mem", "cpu"}, "b": {"mem"}, "c": {"cpu"}}
+	for name, wantFlags := range want {
+		if !slices.Equal(flags[name], wantFlags) {
+			t.Errorf("flags of %s = %v, want %v", name, flags[name], wantFlags)
+		}
+	}
+	if len(resp.TopConsumers) != 1 || len(resp.TopConsumers[0].Consumers) != 1 {
+		t.Fatalf("top consumers = %v, want the top cpu consumer", resp.TopConsumers)
+	}
+	if top := resp.TopConsumers[0].Consumers[0]; top.Namespace != "b" || top.Consumed != "7" || top.Share != 70 {
+		t.Errorf("top consumer = %v, want b with 7 cpus, 70%% of a", top)
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	handler := newTestHandler(t)
+	ctx := userContext(t, testAdmin)
+	addTestNamespace(t, handler, "default", "", map[string]string{"cpu": "10"})
+	addTestNamespace(t, handler, "a", "default", map[string]string{"cpu": "4"})
+
+	addApp := func(name, cpu string) *api.SimulatedMutation {
+		return &api.SimulatedMutation{AddApp: &api.AddAppReq{Namespace: "a", Name: name, QuotaQuantities: map[string]string{"cpu": cpu}}}
+	}
+	_, err := handler.Simulate(ctx, &api.SimulateReq{OrgId: testOrg, Mutations: []*api.SimulatedMutation{addApp("x", "1"), {}}})
+	wantCode(t, "Simulate() with an empty mutation", err, codes.InvalidArgument)
+	_, err = handler.Simulate(ctx, &api.SimulateReq{OrgId: testOrg, Mutations: []*api.SimulatedMutation{{
+		AddApp:    addApp("x", "1").AddApp,
+		RemoveApp: &api.RemoveAppReq{Namespace: "a", Name: "x"},
+	}}})
+	wantCode(t, "Simulate() with two requests in a mutation", err, codes.InvalidArgument)
+
+	resp, err := handler.Simulate(ctx, &api.SimulateReq{OrgId: testOrg, Mutations: []*api.SimulatedMutation{
+		{AddNamespace: &api.AddNamespaceReq{Name: "b", ParentName: "default", QuotaQuantities: map[string]string{"cpu": "4"}}},
+		{AddNamespace: &api.AddNamespaceReq{Name: "c", ParentName: "default", QuotaQuantities: map[string]string{"cpu": "4"}}},
+		addApp("x", "2"),
+		{SetNamespaceResources: &api.SetNamespaceResourcesReq{Name: "a", QuotaQuantities: map[string]string{"cpu": "1"}}},
+		addApp("y", "3"),
+		{RemoveApp: &api.RemoveAppReq{Namespace: "a", Name: "y"}},
+	}})
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	var violations []int32
+	for _, violation := range resp.Violations {
+		violations = append(violations, violation.Mutation)
+	}
+	// c does not fit next to b, a has 2 cpus utilized and y does not fit next to x
+	if want := []int32{1, 3, 4, 5}; !slices.Equal(violations, want) {
+		t.Errorf("violations = %v, want mutations %v", resp.Violations, want)
+	}
+	simulated := make(map[string]*api.SimulatedNamespace)
+	for _, namespace := range resp.Namespaces {
+		simulated[namespace.Name] = namespace
+	}
+	if len(simulated) != 3 || simulated["b"] == nil || simulated["b"].Parent != "default" {
+		t.Fatalf("namespaces = %v, want default, a and b", resp.Namespaces)
+	}
+	if got := simulated["default"].Available["cpu"]; got != "2" {
+		t.Errorf("default available cpu = %q, want 2", got)
+	}
+	if got := simulated["a"].Utilized["cpu"]; got != "2" {
+		t.Errorf("a utilized cpu = %q, want 2 for x alone", got)
+	}
+
+	// none of the mutations are committed
+	_, err = handler.GetNamespace(ctx, &api.GetNamespaceReq{OrgId: testOrg, Name: "b"})
+	wantCode(t, "GetNamespace(b)", err, codes.NotFound)
+	_, err = handler.GetApp(ctx, &api.GetAppReq{OrgId: testOrg, Namespace: "a", Name: "x"})
+	wantCode(t, "GetApp(x)", err, codes.NotFound)
+}
+
+func TestDryRun(t *testing.T) {
+	publisher := &fakePublisher{}
+	handler := newTestHandlerWith(t, Auth{TokenSecret: []byte(testSecret)}, publisher)
+	ctx := userContext(t, testAdmin)
+	addTestNamespace(t, handler, "a", "", map[string]string{"cpu": "4"})
+	addTestNamespace(t, handler, "b", "a", map[string]string{"cpu": "2"})
+	addNamespace := func(name, parent, cpu string) (*api.AddNamespaceResp, error) {
+		return handler.AddNamespace(ctx, &api.AddNamespaceReq{OrgId: testOrg, Name: name, ParentName: parent, QuotaQuantities: map[string]string{"cpu": cpu}, DryRun: true})
+	}
+	addApp := func(name, cpu string, dryRun bool) (*api.AddAppResp, error) {
+		return handler.AddApp(ctx, &api.AddAppReq{
+			OrgId:                     testOrg,
+			Namespace:                 "b",
+			Name:                      name,
+			QuotaQuantities:           map[string]string{"cpu": cpu},
+			SeccompDefinitionStrategy: "redefine",
+			Profile:                   &api.SeccompProfile{Version: "v1"},
+			DryRun:                    dryRun,
+		})
+	}
+
+	_, err := addNamespace("c", "a", "3")
+	wantCode(t, "AddNamespace() beyond the parent available", err, codes.InvalidArgument)
+	_, err = addNamespace("c", "", "1")
+	wantCode(t, "AddNamespace() inheriting without a parent", err, codes.InvalidArgument)
+	namespaceResp, err := addNamespace("c", "a", "1")
+	if err != nil {
+		t.Fatalf("AddNamespace() error = %v", err)
+	}
+	if namespaceResp.SeccompStrategy != "inherit" || namespaceResp.ParentAvailable["cpu"] != "1" {
+		t.Errorf("AddNamespace() = %v, want inherit with 1 cpu left in a", namespaceResp)
+	}
+	_, err = handler.GetNamespace(ctx, &api.GetNamespaceReq{OrgId: testOrg, Name: "c"})
+	wantCode(t, "GetNamespace() after a dry run", err, codes.NotFound)
+
+	appResp, err := addApp("y", "1", true)
+	if err != nil {
+		t.Fatalf("AddApp() error = %v", err)
+	}
+	if !slices.Equal(appResp.Nodes, []string{"node"}) || appResp.NamespaceAvailable["cpu"] != "1" {
+		t.Errorf("AddApp() = %v, want the selected node with 1 cpu left in b", appResp)
+	}
+	_, err = handler.GetApp(ctx, &api.GetAppReq{OrgId: testOrg, Namespace: "b", Name: "y"})
+	wantCode(t, "GetApp() after a dry run", err, codes.NotFound)
+	if _, err := addApp("x", "1", false); err != nil {
+		t.Fatalf("AddApp(x) error = %v", err)
+	}
+
+	setAppResp, err := handler.SetAppResources(ctx, &api.SetAppResourcesReq{OrgId: testOrg, Namespace: "b", Name: "x", QuotaQuantities: map[string]string{"cpu": "2"}, DryRun: true})
+	if err != nil {
+		t.Fatalf("SetAppResources() error = %v", err)
+	}
+	if got := setAppResp.NamespaceAvailable["cpu"]; got != "0" {
+		t.Errorf("SetAppResources() namespace available cpu = %q, want 0", got)
+	}
+	setNamespaceResp, err := handler.SetNamespaceResources(ctx, &api.SetNamespaceResourcesReq{OrgId: testOrg, Name: "b", QuotaQuantities: map[string]string{"cpu": "3"}, DryRun: true})
+	if err != nil {
+		t.Fatalf("SetNamespaceResources() error = %v", err)
+	}
+	if setNamespaceResp.Available["cpu"] != "2" || setNamespaceResp.ParentAvailable["cpu"] != "1" {
+		t.Errorf("SetNamespaceResources() = %v, want 2 cpus left in b and 1 in a", setNamespaceResp)
+	}
+	_, err = handler.RemoveApp(ctx, &api.RemoveAppReq{OrgId: testOrg, Namespace: "b", Name: "x", DryRun: true})
+	wantCode(t, "RemoveApp()", err, codes.OK)
+	removeResp, err := handler.RemoveNamespace(ctx, &api.RemoveNamespaceReq{OrgId: testOrg, Name: "b", Cascade: true, DryRun: true})
+	if err != nil {
+		t.Fatalf("RemoveNamespace() error = %v", err)
+	}
+	if !slices.Equal(removeResp.Namespaces, []string{"b"}) || len(removeResp.Apps) != 1 {
+		t.Errorf("RemoveNamespace() = %v, want b and its app", removeResp)
+	}
+
+	// none of the dry runs changed anything or told the nodes to remove the app
+	app, err := handler.GetApp(ctx, &api.GetAppReq{OrgId: testOrg, Namespace: "b", Name: "x"})
+	if err != nil {
+		t.Fatalf("GetApp() error = %v", err)
+	}
+	if got := app.TotalQuantities["cpu"]; got != "1" {
+		t.Errorf("app cpu = %q, want 1", got)
+	}
+	namespace, err := handler.GetNamespace(ctx, &api.GetNamespaceReq{OrgId: testOrg, Name: "b"})
+	if err != nil {
+		t.Fatalf("GetNamespace() error = %v", err)
+	}
+	if got := namespace.TotalQuantities["cpu"]; got != "2" {
+		t.Errorf("b cpu = %q, want 2", got)
+	}
+	if messages := publisher.Messages(api.RemoveSubject("node")); len(messages) != 0 {
+		t.Errorf("published %d app removals, want none", len(messages))
+	}
+}
@@ -0,0 +1,150 @@
+package store
+
+import (
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+type appMemoryStore struct {
+	db *MemoryDb
+}
+
+func NewAppMemoryStore(db *MemoryDb) domain.AppStore {
+	if db == nil {
+		log.Fatalln("db is nil while initializing app memory store")
+	}
+	return &appMemoryStore{
+		db: db,
+	}
+}
+
+func (a *appMemoryStore) Add(tx domain.Tx, app domain.App) error {
+	return a.db.atomic(tx, func(tx *memoryTx) error {
+		if _, found := tx.entities[app.GetId()]; found {
+			return fmt.Errorf("app %s already exists", app.GetId())
+		}
+		if _, found := tx.get(app.GetNamespace().GetId(), memoryNamespace); !found {
+			return fmt.Errorf("cannot find namespace %s", app.GetNamespace().GetId())
+		}
+		tx.add(&memoryEntity{
+			id:             app.GetId(),
+			kind:           memoryApp,
+			name:           app.GetName(),
+			profileVersion: app.GetProfileVersion(),
+			quotas:         make(domain.ResourceQuotas),
+			parentId:       app.GetNamespace().GetId(),
+		})
+
+		return tx.setResourceQuotas(app.GetId(), app.GetResourceQuotas())
+	})
+}
+
+func (a *appMemoryStore) Get(tx domain.Tx, id string) (domain.App, error) {
+	var app domain.App
+	err := a.db.read(tx, func(tx *memoryTx) error {
+		var err error
+		app, err = a.get(tx, id)
+		return err
+	})
+	if err != nil {
+		return domain.App{}, err
+	}
+	return app, nil
+}
+
+func (a *appMemoryStore) FindChildren(tx domain.Tx, namespace domain.Namespace) ([]domain.App, error) {
+	apps := make([]domain.App, 0)
+	err := a.db.read(tx, func(tx *memoryTx) error {
+		for _, entity := range tx.children(namespace.GetId(), memoryApp) {
+			apps = append(apps, toApp(namespace, entity))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+func (a *appMemoryStore) List(tx domain.Tx, query domain.AppQuery) ([]domain.App, error) {
+	apps := make([]domain.App, 0)
+	err := a.db.read(tx, func(tx *memoryTx) error {
+		ids := make([]string, 0)
+		for id, entity := range tx.entities {
+			if entity.kind != memoryApp || id <= query.After {
+				continue
+			}
+			namespace, found := tx.get(entity.parentId, memoryNamespace)
+			if !found || namespace.orgId != query.OrgId || (query.NamespaceId != "" && namespace.id != query.NamespaceId) {
+				continue
+			}
+			ids = append(ids, id)
+		}
+		slices.Sort(ids)
+		if len(ids) > query.Limit {
+			ids = ids[:query.Limit]
+		}
+		for _, id := range ids {
+			app, err := a.get(tx, id)
+			if err != nil {
+				return err
+			}
+			apps = append(apps, app)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+func (a *appMemoryStore) SetNodes(tx domain.Tx, id string, nodes []string) error {
+	return a.db.atomic(tx, func(tx *memoryTx) error {
+		entity, found := tx.get(id, memoryApp)
+		if !found {
+			return fmt.Errorf("cannot find app %s", id)
+		}
+		entity.nodes = slices.Clone(nodes)
+		return nil
+	})
+}
+
+func (a *appMemoryStore) Remove(tx domain.Tx, id string) error {
+	return a.db.atomic(tx, func(tx *memoryTx) error {
+		if _, found := tx.get(id, memoryApp); found {
+			tx.remove(id)
+		}
+		return nil
+	})
+}
+
+func (a *appMemoryStore) get(tx *memoryTx, id string) (domain.App, error) {
+	entity, found := tx.get(id, memoryApp)
+	if !found {
+		return domain.App{}, fmt.Errorf("cannot find app %s", id)
+	}
+	namespaceEntity, found := tx.get(entity.parentId, memoryNamespace)
+	if !found {
+		return domain.App{}, fmt.Errorf("app %s has no namespace", id)
+	}
+	namespace, err := tx.toNamespace(namespaceEntity)
+	if err != nil {
+		return domain.App{}, err
+	}
+	return toApp(namespace, entity), nil
+}
+
+func toApp(namespace domain.Namespace, entity *memoryEntity) domain.App {
+	app := domain.NewApp(namespace, entity.name, entity.profileVersion)
+	for resourceName, quota := range entity.quotas {
+		if err := app.AddResourceQuota(resourceName, quota); err != nil {
+			log.Println(err)
+		}
+	}
+	app.SetNodes(entity.nodes)
+	return app
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/c12s/meridian/internal/domain"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
@@ -25,68 +26,99 @@ func NewAppNeo4jStore(driver neo4j.Driver, dbName string, quotas domain.Resource
 	}
 }
 
-func (a *appNeo4jStore) Add(app domain.App) error {
-	session := startSession(a.driver, a.dbName)
-	defer endSession(session)
-	tx, err := session.BeginTransaction()
-	if err != nil {
-		return err
-	}
+func (a *appNeo4jStore) Add(tx domain.Tx, app domain.App) error {
+	return atomic(a.driver, a.dbName, tx, func(tx neo4j.Transaction) error {
+		_, err := tx.Run(addAppCypher, map[string]any{
+			"id":              app.GetId(),
+			"name":            app.GetName(),
+			"profile_version": app.GetProfileVersion(),
+			"namespace_id":    app.GetNamespace().GetId(),
+		})
+		if err != nil {
+			return err
+		}
 
-	_, err = tx.Run(addAppCypher, map[string]any{
-		"id":              app.GetId(),
-		"name":            app.GetName(),
-		"profile_version": app.GetProfileVersion(),
-		"namespace_id":    app.GetNamespace().GetId(),
+		return a.quotas.SetResourceQuotas(tx, app.GetId(), app.GetResourceQuotas())
 	})
-	if err != nil {
-		tx.Rollback()
-		return err
-	}
+}
 
-	err = a.quotas.SetResourceQuotas(app.GetId(), app.GetResourceQuotas(), tx)
-	if err != nil {
-		tx.Rollback()
+func (a *appNeo4jStore) FindChildren(tx domain.Tx, namespace domain.Namespace) ([]domain.App, error) {
+	var apps []domain.App
+	err := atomic(a.driver, a.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(getChildAppsCypher, map[string]any{
+			"id": namespace.GetId(),
+		})
+		if err != nil {
+			return err
+		}
+		apps, err = a.readApps(res, namespace)
 		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	tx.Commit()
-	return nil
+	return apps, nil
 }
 
-func (a *appNeo4jStore) FindChildren(namespace domain.Namespace) ([]domain.App, error) {
-	session := startSession(a.driver, a.dbName)
-	defer endSession(session)
-	tx, err := session.BeginTransaction()
+func (a *appNeo4jStore) Get(tx domain.Tx, id string) (domain.App, error) {
+	var apps []domain.App
+	err := atomic(a.driver, a.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(getAppCypher, map[string]any{
+			"id": id,
+		})
+		if err != nil {
+			return err
+		}
+		apps, err = a.readAppsWithNamespaces(res)
+		return err
+	})
 	if err != nil {
-		return nil, err
+		return domain.App{}, err
 	}
-	defer tx.Commit()
-	res, err := tx.Run(getChildAppsCypher, map[string]any{
-		"id": namespace.GetId(),
+	if len(apps) == 0 {
+		return domain.App{}, fmt.Errorf("cannot find app %s", id)
+	}
+	return apps[0], nil
+}
+
+func (a *appNeo4jStore) List(tx domain.Tx, query domain.AppQuery) ([]domain.App, error) {
+	var apps []domain.App
+	err := atomic(a.driver, a.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(listAppsCypher, map[string]any{
+			"org_id":       query.OrgId,
+			"namespace_id": query.NamespaceId,
+			"after":        query.After,
+			"limit":        query.Limit,
+		})
+		if err != nil {
+			return err
+		}
+		apps, err = a.readAppsWithNamespaces(res)
+		return err
 	})
 	if err != nil {
 		return nil, err
 	}
-
-	return a.readApps(res, namespace)
+	return apps, nil
 }
 
-func (a *appNeo4jStore) Remove(id string) error {
-	session := startSession(a.driver, a.dbName)
-	defer endSession(session)
-	tx, err := session.BeginTransaction()
-	if err != nil {
+func (a *appNeo4jStore) SetNodes(tx domain.Tx, id string, nodes []string) error {
+	return atomic(a.driver, a.dbName, tx, func(tx neo4j.Transaction) error {
+		_, err := tx.Run(setAppNodesCypher, map[string]any{
+			"id":    id,
+			"nodes": nodes,
+		})
 		return err
-	}
-	_, err = tx.Run(removeAppCypher, map[string]any{
-		"id": id,
 	})
-	if err != nil {
-		tx.Rollback()
+}
+
+func (a *appNeo4jStore) Remove(tx domain.Tx, id string) error {
+	return atomic(a.driver, a.dbName, tx, func(tx neo4j.Transaction) error {
+		_, err := tx.Run(removeAppCypher, map[string]any{
+			"id": id,
+		})
 		return err
-	}
-	tx.Commit()
-	return nil
+	})
 }
 
 func (a *appNeo4jStore) readApps(res neo4j.Result, namespace domain.Namespace) ([]domain.App, error) {
@@ -110,46 +142,129 @@ func (a *appNeo4jStore) readApps(res neo4j.Result, namespace domain.Namespace) (
 		if !ok {
 			return apps, fmt.Errorf("app has no properties")
 		}
-		nameAny, found := properties["name"]
+		app, err := readApp(properties, namespace)
+		if err != nil {
+			return apps, err
+		}
+		apps = append(apps, app)
+	}
+	return apps, nil
+}
+
+// readAppsWithNamespaces reads apps of different namespaces, every record holds the app and its namespace.
+func (a *appNeo4jStore) readAppsWithNamespaces(res neo4j.Result) ([]domain.App, error) {
+	apps := make([]domain.App, 0)
+	if res.Err() != nil {
+		return apps, res.Err()
+	}
+	records, err := res.Collect()
+	if err != nil {
+		return apps, err
+	}
+	for _, record := range records {
+		namespaceAny, found := record.Get("namespace")
 		if !found {
-			return apps, fmt.Errorf("app has no name")
+			return apps, fmt.Errorf("app has no namespace")
 		}
-		name, ok := nameAny.(string)
+		namespaceProperties, ok := namespaceAny.(map[string]any)
 		if !ok {
-			return apps, fmt.Errorf("app name invalid type")
+			return apps, fmt.Errorf("app has no namespace")
 		}
-		profileVersionAny, found := properties["profile_version"]
+		namespace, err := readNamespace(namespaceProperties, "")
+		if err != nil {
+			return apps, err
+		}
+		propertiesAny, found := record.Get("properties")
 		if !found {
-			return apps, fmt.Errorf("app has no profile_version")
+			return apps, fmt.Errorf("app has no properties")
 		}
-		profileVersion, ok := profileVersionAny.(string)
+		properties, ok := propertiesAny.(map[string]any)
 		if !ok {
-			return apps, fmt.Errorf("app profile_version invalid type")
-		}
-		app := domain.NewApp(namespace, name, profileVersion)
-		for _, resourceName := range domain.SupportedResourceQuotas {
-			quotaAny, found := properties[resourceName]
-			if found {
-				if quota, ok := quotaAny.(float64); !ok {
-					log.Printf("invalid quota type for resource name %s: %v\n", resourceName, quotaAny)
-				} else {
-					if err := app.AddResourceQuota(resourceName, quota); err != nil {
-						log.Println(err)
-					}
-				}
-			}
+			return apps, fmt.Errorf("app has no properties")
+		}
+		app, err := readApp(properties, namespace)
+		if err != nil {
+			return apps, err
 		}
 		apps = append(apps, app)
 	}
 	return apps, nil
 }
 
+func readApp(properties map[string]any, namespace domain.Namespace) (domain.App, error) {
+	nameAny, found := properties["name"]
+	if !found {
+		return domain.App{}, fmt.Errorf("app has no name")
+	}
+	name, ok := nameAny.(string)
+	if !ok {
+		return domain.App{}, fmt.Errorf("app name invalid type")
+	}
+	profileVersionAny, found := properties["profile_version"]
+	if !found {
+		return domain.App{}, fmt.Errorf("app has no profile_version")
+	}
+	profileVersion, ok := profileVersionAny.(string)
+	if !ok {
+		return domain.App{}, fmt.Errorf("app profile_version invalid type")
+	}
+	app := domain.NewApp(namespace, name, profileVersion)
+	for key, quotaAny := range properties {
+		resourceName, found := strings.CutPrefix(key, quotaPropertyPrefix)
+		if !found {
+			continue
+		}
+		if quota, ok := quotaAny.(int64); !ok {
+			log.Printf("invalid quota type for resource name %s: %v\n", resourceName, quotaAny)
+		} else {
+			if err := app.AddResourceQuota(resourceName, quota); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+	if nodesAny, found := properties["nodes"]; found {
+		nodesList, ok := nodesAny.([]any)
+		if !ok {
+			return domain.App{}, fmt.Errorf("app nodes invalid type")
+		}
+		nodes := make([]string, 0, len(nodesList))
+		for _, nodeAny := range nodesList {
+			node, ok := nodeAny.(string)
+			if !ok {
+				return domain.App{}, fmt.Errorf("app node invalid type: %v", nodeAny)
+			}
+			nodes = append(nodes, node)
+		}
+		app.SetNodes(nodes)
+	}
+	return app, nil
+}
+
 const addAppCypher = `
 MATCH (n:Namespace{id: $namespace_id})
 CREATE (a:App:Entity{id: $id, name: $name, profile_version: $profile_version})
 CREATE (n)-[:CHILD]->(a);
 `
 
+const getAppCypher = `
+MATCH (n:Namespace)-[:CHILD]->(a:App{id: $id})
+RETURN properties(n) AS namespace, properties(a) AS properties;
+`
+
+const listAppsCypher = `
+MATCH (n:Namespace{org_id: $org_id})-[:CHILD]->(a:App)
+WHERE ($namespace_id = '' OR n.id = $namespace_id) AND a.id > $after
+WITH n, a
+ORDER BY a.id
+LIMIT $limit
+RETURN properties(n) AS namespace, properties(a) AS properties;
+`
+
+const setAppNodesCypher = `
+MATCH (a:App{id: $id})
+SET a.nodes = $nodes;
+`
+
 const removeAppCypher = `
 MATCH (a:App{id: $id})
 DETACH DELETE a;
@@ -0,0 +1,398 @@
+package store
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+type memoryEntityKind int
+
+const (
+	memoryNamespace memoryEntityKind = iota
+	memoryApp
+	memoryReservation
+)
+
+type memoryEntity struct {
+	id             string
+	kind           memoryEntityKind
+	orgId          string
+	name           string
+	profileVersion string
+	labels         map[string]string
+	quotas         domain.ResourceQuotas
+	overcommits    domain.Overcommits
+	elasticMax     domain.ResourceQuotas
+	limitRange     domain.LimitRange
+	countQuotas    domain.CountQuotas
+	thresholds     domain.UtilizationThresholds
+	parentId       string
+	childIds       []string
+	nodes          []string
+	expiresAt      time.Time
+}
+
+func (e *memoryEntity) clone() *memoryEntity {
+	clone := *e
+	clone.labels = maps.Clone(e.labels)
+	clone.quotas = maps.Clone(e.quotas)
+	clone.overcommits = maps.Clone(e.overcommits)
+	clone.elasticMax = maps.Clone(e.elasticMax)
+	clone.limitRange = e.limitRange.Clone()
+	clone.thresholds = maps.Clone(e.thresholds)
+	clone.childIds = slices.Clone(e.childIds)
+	clone.nodes = slices.Clone(e.nodes)
+	return &clone
+}
+
+// MemoryDb holds the namespace and app graph shared by the in-memory stores.
+// It mirrors the Entity nodes and CHILD edges of the neo4j model.
+type MemoryDb struct {
+	mu                   sync.RWMutex
+	entities             map[string]*memoryEntity
+	resourceTypes        map[string]domain.ResourceType
+	resourceTypesVersion int64
+	quotaRequests        map[string]domain.QuotaRequest
+	schedules            map[string]domain.ScheduledQuotaChange
+	prices               map[string]domain.ResourcePrice
+	history              []domain.QuotaSample
+}
+
+func NewMemoryDb() *MemoryDb {
+	return &MemoryDb{
+		entities:      make(map[string]*memoryEntity),
+		resourceTypes: make(map[string]domain.ResourceType),
+		quotaRequests: make(map[string]domain.QuotaRequest),
+		schedules:     make(map[string]domain.ScheduledQuotaChange),
+		prices:        make(map[string]domain.ResourcePrice),
+	}
+}
+
+// memoryTx is a copy of the graph that is swapped into the db on commit.
+type memoryTx struct {
+	entities             map[string]*memoryEntity
+	resourceTypes        map[string]domain.ResourceType
+	resourceTypesVersion int64
+	quotaRequests        map[string]domain.QuotaRequest
+	schedules            map[string]domain.ScheduledQuotaChange
+	prices               map[string]domain.ResourcePrice
+	// samples are only appended, a transaction appends past the end of the
+	// samples of the db, which is not visible until the transaction is committed
+	history []domain.QuotaSample
+}
+
+// atomic runs fn in tx if one is given. Otherwise it runs fn on a copy of the
+// graph under the write lock and keeps the copy only if fn succeeds.
+func (db *MemoryDb) atomic(tx domain.Tx, fn func(tx *memoryTx) error) error {
+	if tx != nil {
+		memTx, ok := tx.(*memoryTx)
+		if !ok {
+			return fmt.Errorf("transaction of type %T is not a memory transaction", tx)
+		}
+		return fn(memTx)
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	newTx := &memoryTx{
+		entities:             make(map[string]*memoryEntity, len(db.entities)),
+		resourceTypes:        maps.Clone(db.resourceTypes),
+		resourceTypesVersion: db.resourceTypesVersion,
+		quotaRequests:        maps.Clone(db.quotaRequests),
+		schedules:            maps.Clone(db.schedules),
+		prices:               maps.Clone(db.prices),
+		history:              db.history,
+	}
+	for id, entity := range db.entities {
+		newTx.entities[id] = entity.clone()
+	}
+	err := fn(newTx)
+	if err != nil {
+		return err
+	}
+	db.entities = newTx.entities
+	db.resourceTypes = newTx.resourceTypes
+	db.resourceTypesVersion = newTx.resourceTypesVersion
+	db.quotaRequests = newTx.quotaRequests
+	db.schedules = newTx.schedules
+	db.prices = newTx.prices
+	db.history = newTx.history
+	return nil
+}
+
+// read runs fn in tx if one is given, otherwise directly on the graph under the read lock.
+func (db *MemoryDb) read(tx domain.Tx, fn func(tx *memoryTx) error) error {
+	if tx != nil {
+		return db.atomic(tx, fn)
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return fn(&memoryTx{entities: db.entities, resourceTypes: db.resourceTypes, resourceTypesVersion: db.resourceTypesVersion, quotaRequests: db.quotaRequests, schedules: db.schedules, prices: db.prices, history: db.history})
+}
+
+type memoryTxManager struct {
+	db *MemoryDb
+}
+
+func NewMemoryTxManager(db *MemoryDb) domain.TxManager {
+	if db == nil {
+		log.Fatalln("db is nil while initializing memory tx manager")
+	}
+	return &memoryTxManager{
+		db: db,
+	}
+}
+
+func (m *memoryTxManager) Atomic(fn func(tx domain.Tx) error) error {
+	return m.db.atomic(nil, func(tx *memoryTx) error {
+		return fn(tx)
+	})
+}
+
+func (tx *memoryTx) get(id string, kind memoryEntityKind) (*memoryEntity, bool) {
+	entity, found := tx.entities[id]
+	if !found || entity.kind != kind {
+		return nil, false
+	}
+	return entity, true
+}
+
+func (tx *memoryTx) add(entity *memoryEntity) {
+	tx.entities[entity.id] = entity
+	tx.attach(entity)
+}
+
+// attach adds the CHILD edge from the entity parent to the entity.
+func (tx *memoryTx) attach(entity *memoryEntity) {
+	if parent, found := tx.entities[entity.parentId]; found {
+		parent.childIds = append(parent.childIds, entity.id)
+	}
+}
+
+// detach removes the CHILD edge from the entity parent to the entity.
+func (tx *memoryTx) detach(entity *memoryEntity) {
+	if parent, found := tx.entities[entity.parentId]; found {
+		parent.childIds = slices.DeleteFunc(parent.childIds, func(childId string) bool {
+			return childId == entity.id
+		})
+	}
+	entity.parentId = ""
+}
+
+func (tx *memoryTx) remove(id string) {
+	entity, found := tx.entities[id]
+	if !found {
+		return
+	}
+	tx.detach(entity)
+	for _, childId := range entity.childIds {
+		if child, found := tx.entities[childId]; found {
+			child.parentId = ""
+		}
+	}
+	delete(tx.entities, id)
+}
+
+func (tx *memoryTx) children(id string, kind memoryEntityKind) []*memoryEntity {
+	entity, found := tx.entities[id]
+	if !found {
+		return nil
+	}
+	children := make([]*memoryEntity, 0)
+	for _, childId := range entity.childIds {
+		if child, found := tx.entities[childId]; found && child.kind == kind {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// counts returns the number of apps and namespaces in the subtree of the entity.
+func (tx *memoryTx) counts(id string) domain.ObjectCounts {
+	counts := domain.ObjectCounts{
+		Apps: int64(len(tx.children(id, memoryApp))),
+	}
+	for _, child := range tx.children(id, memoryNamespace) {
+		counts.Namespaces++
+		counts = counts.Add(tx.counts(child.id))
+	}
+	return counts
+}
+
+func (tx *memoryTx) toNamespace(entity *memoryEntity) (domain.Namespace, error) {
+	namespace := domain.NewNamespace(entity.orgId, entity.name, entity.profileVersion, maps.Clone(entity.labels))
+	for resourceName, quota := range entity.quotas {
+		if err := namespace.AddResourceQuota(resourceName, quota); err != nil {
+			log.Println(err)
+		}
+	}
+	namespace.SetOvercommits(entity.overcommits)
+	namespace.SetLimitRange(entity.limitRange)
+	namespace.SetCountQuotas(entity.countQuotas)
+	namespace.SetUtilizationThresholds(entity.thresholds)
+	namespace.SetElasticMax(entity.elasticMax)
+	available, err := tx.getAvailableResources(entity.id)
+	if err != nil {
+		return domain.Namespace{}, err
+	}
+	err = namespace.SetAvailable(available)
+	if err != nil {
+		return domain.Namespace{}, err
+	}
+	utilized, err := tx.getUtilizedResources(entity.id)
+	if err != nil {
+		return domain.Namespace{}, err
+	}
+	namespace.SetUtilized(utilized)
+	return namespace, nil
+}
+
+// getResourceUsage returns the usage of the resources the entity has quotas for.
+func (tx *memoryTx) getResourceUsage(entityId string) (map[string]domain.ResourceUsage, error) {
+	entity, found := tx.entities[entityId]
+	if !found {
+		return nil, fmt.Errorf("available resources not found for entity %s", entityId)
+	}
+	usage := make(map[string]domain.ResourceUsage)
+	for resourceName, quota := range entity.quotas {
+		resourceUsage := domain.ResourceUsage{
+			Total:      domain.EffectiveQuota(quota, entity.overcommits.Get(resourceName)),
+			Utilized:   tx.utilized(entity, resourceName),
+			ElasticMax: entity.elasticMax[resourceName],
+		}
+		if parent, found := tx.entities[entity.parentId]; found {
+			for _, sibling := range tx.children(parent.id, memoryNamespace) {
+				if sibling.id == entity.id {
+					continue
+				}
+				siblingTotal := domain.EffectiveQuota(sibling.quotas[resourceName], sibling.overcommits.Get(resourceName))
+				resourceUsage.SiblingSpare += siblingTotal - tx.utilized(sibling, resourceName)
+			}
+		}
+		usage[resourceName] = resourceUsage
+	}
+	return usage, nil
+}
+
+// utilized returns the sum of the quotas of the entity children.
+func (tx *memoryTx) utilized(entity *memoryEntity, resourceName string) int64 {
+	var utilized int64
+	for _, childId := range entity.childIds {
+		if child, found := tx.entities[childId]; found {
+			utilized += child.quotas[resourceName]
+		}
+	}
+	return utilized
+}
+
+func (tx *memoryTx) getAvailableResources(entityId string) (domain.ResourceQuotas, error) {
+	usage, err := tx.getResourceUsage(entityId)
+	if err != nil {
+		return nil, err
+	}
+	available := make(domain.ResourceQuotas)
+	for resourceName, resourceUsage := range usage {
+		available[resourceName] = resourceUsage.Available()
+	}
+	return available, nil
+}
+
+func (tx *memoryTx) getUtilizedResources(entityId string) (domain.ResourceQuotas, error) {
+	usage, err := tx.getResourceUsage(entityId)
+	if err != nil {
+		return nil, err
+	}
+	utilized := make(domain.ResourceQuotas)
+	for resourceName, resourceUsage := range usage {
+		utilized[resourceName] = resourceUsage.Utilized
+	}
+	return utilized, nil
+}
+
+func (tx *memoryTx) setResourceQuotas(entityId string, quotas domain.ResourceQuotas) error {
+	entity, found := tx.entities[entityId]
+	if !found {
+		return fmt.Errorf("entity not found")
+	}
+	total := entity.quotas
+
+	if parent, found := tx.entities[entity.parentId]; found {
+		availableParent, err := tx.getAvailableResources(parent.id)
+		if err != nil {
+			return err
+		}
+		for resource, quota := range quotas {
+			if available := availableParent[resource] + total[resource]; available < quota {
+				return fmt.Errorf("requested %s quota for the resource %s, but only %s available in parent", domain.FormatMilliUnits(quota), resource, domain.FormatMilliUnits(available))
+			}
+		}
+	}
+
+	usage, err := tx.getResourceUsage(entityId)
+	if err != nil {
+		return err
+	}
+	for resource, quota := range quotas {
+		resourceUsage := usage[resource]
+		resourceUsage.Total = domain.EffectiveQuota(quota, entity.overcommits.Get(resource))
+		if !resourceUsage.Fits() {
+			return fmt.Errorf("requested %s quota for the resource %s, but %s already utilized", domain.FormatMilliUnits(quota), resource, domain.FormatMilliUnits(resourceUsage.Utilized))
+		}
+	}
+
+	maps.Copy(entity.quotas, quotas)
+	return nil
+}
+
+func (tx *memoryTx) setOvercommits(entityId string, overcommits domain.Overcommits) error {
+	entity, found := tx.entities[entityId]
+	if !found {
+		return fmt.Errorf("entity not found")
+	}
+	usage, err := tx.getResourceUsage(entityId)
+	if err != nil {
+		return err
+	}
+	for resource, overcommit := range overcommits {
+		resourceUsage := usage[resource]
+		resourceUsage.Total = domain.EffectiveQuota(entity.quotas[resource], overcommit)
+		if !resourceUsage.Fits() {
+			return fmt.Errorf("overcommit of the resource %s leaves %s effective quota, but %s already utilized", resource, domain.FormatMilliUnits(resourceUsage.Total), domain.FormatMilliUnits(resourceUsage.Utilized))
+		}
+	}
+	if entity.overcommits == nil {
+		entity.overcommits = make(domain.Overcommits)
+	}
+	maps.Copy(entity.overcommits, overcommits)
+	return nil
+}
+
+func (tx *memoryTx) setElasticMax(entityId string, elasticMax domain.ResourceQuotas) error {
+	entity, found := tx.entities[entityId]
+	if !found {
+		return fmt.Errorf("entity not found")
+	}
+	usage, err := tx.getResourceUsage(entityId)
+	if err != nil {
+		return err
+	}
+	for resource, elastic := range elasticMax {
+		resourceUsage := usage[resource]
+		resourceUsage.ElasticMax = elastic
+		if !resourceUsage.Fits() {
+			return fmt.Errorf("requested %s elastic max for the resource %s, but %s already utilized", domain.FormatMilliUnits(elastic), resource, domain.FormatMilliUnits(resourceUsage.Utilized))
+		}
+	}
+	if entity.elasticMax == nil {
+		entity.elasticMax = make(domain.ResourceQuotas)
+	}
+	maps.Copy(entity.elasticMax, elasticMax)
+	return nil
+}
@@ -0,0 +1,253 @@
+package store
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+type namespaceMemoryStore struct {
+	db *MemoryDb
+}
+
+func NewNamespaceMemoryStore(db *MemoryDb) domain.NamespaceStore {
+	if db == nil {
+		log.Fatalln("db is nil while initializing namespace memory store")
+	}
+	return &namespaceMemoryStore{
+		db: db,
+	}
+}
+
+func (n *namespaceMemoryStore) Add(tx domain.Tx, namespace domain.Namespace, parent *domain.Namespace) error {
+	return n.db.atomic(tx, func(tx *memoryTx) error {
+		if _, found := tx.entities[namespace.GetId()]; found {
+			return fmt.Errorf("namespace %s already exists", namespace.GetId())
+		}
+		entity := &memoryEntity{
+			id:             namespace.GetId(),
+			kind:           memoryNamespace,
+			orgId:          namespace.GetOrgId(),
+			name:           namespace.GetName(),
+			profileVersion: namespace.GetProfileVersion(),
+			labels:         maps.Clone(namespace.GetLabels()),
+			quotas:         make(domain.ResourceQuotas),
+		}
+		if parent != nil {
+			if _, found := tx.get(parent.GetId(), memoryNamespace); !found {
+				return fmt.Errorf("cannot find namespace %s", parent.GetId())
+			}
+			entity.parentId = parent.GetId()
+		}
+		tx.add(entity)
+
+		return tx.setResourceQuotas(namespace.GetId(), namespace.GetResourceQuotas())
+	})
+}
+
+func (n *namespaceMemoryStore) Get(tx domain.Tx, id string) (domain.Namespace, error) {
+	var namespace domain.Namespace
+	err := n.db.read(tx, func(tx *memoryTx) error {
+		var err error
+		namespace, err = n.get(tx, id)
+		return err
+	})
+	if err != nil {
+		return domain.Namespace{}, err
+	}
+	return namespace, nil
+}
+
+func (n *namespaceMemoryStore) GetHierarchy(tx domain.Tx, rootId string) (domain.NamespaceTree, error) {
+	var tree domain.NamespaceTree
+	err := n.db.read(tx, func(tx *memoryTx) error {
+		root, err := n.get(tx, rootId)
+		if err != nil {
+			return err
+		}
+		rootNode := &domain.NamespaceTreeNode{Namespace: &root}
+		err = n.populateTree(tx, rootNode)
+		if err != nil {
+			return err
+		}
+		tree = domain.NamespaceTree{Root: *rootNode}
+		return nil
+	})
+	if err != nil {
+		return domain.NamespaceTree{}, err
+	}
+	return tree, nil
+}
+
+func (n *namespaceMemoryStore) List(tx domain.Tx, query domain.NamespaceQuery) ([]domain.Namespace, error) {
+	namespaces := make([]domain.Namespace, 0)
+	err := n.db.read(tx, func(tx *memoryTx) error {
+		ids := make([]string, 0)
+		for id, entity := range tx.entities {
+			if entity.kind == memoryNamespace && entity.orgId == query.OrgId && id > query.After && query.Selector.Matches(entity.labels) {
+				ids = append(ids, id)
+			}
+		}
+		slices.Sort(ids)
+		if len(ids) > query.Limit {
+			ids = ids[:query.Limit]
+		}
+		for _, id := range ids {
+			namespace, err := n.get(tx, id)
+			if err != nil {
+				return err
+			}
+			namespaces = append(namespaces, namespace)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+func (n *namespaceMemoryStore) GetParent(tx domain.Tx, id string) (*domain.Namespace, error) {
+	var parent *domain.Namespace
+	err := n.db.read(tx, func(tx *memoryTx) error {
+		entity, found := tx.get(id, memoryNamespace)
+		if !found {
+			return fmt.Errorf("cannot find namespace %s", id)
+		}
+		if entity.parentId == "" {
+			return nil
+		}
+		namespace, err := n.get(tx, entity.parentId)
+		if err != nil {
+			return err
+		}
+		parent = &namespace
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parent, nil
+}
+
+func (n *namespaceMemoryStore) Move(tx domain.Tx, id string, parent *domain.Namespace) error {
+	return n.db.atomic(tx, func(tx *memoryTx) error {
+		entity, found := tx.get(id, memoryNamespace)
+		if !found {
+			return fmt.Errorf("cannot find namespace %s", id)
+		}
+		if parent != nil {
+			if _, found := tx.get(parent.GetId(), memoryNamespace); !found {
+				return fmt.Errorf("cannot find namespace %s", parent.GetId())
+			}
+		}
+		tx.detach(entity)
+		if parent != nil {
+			entity.parentId = parent.GetId()
+			tx.attach(entity)
+		}
+		return nil
+	})
+}
+
+func (n *namespaceMemoryStore) SetLabels(tx domain.Tx, id string, labels map[string]string) error {
+	return n.db.atomic(tx, func(tx *memoryTx) error {
+		entity, found := tx.get(id, memoryNamespace)
+		if !found {
+			return fmt.Errorf("cannot find namespace %s", id)
+		}
+		entity.labels = maps.Clone(labels)
+		return nil
+	})
+}
+
+func (n *namespaceMemoryStore) SetLimitRange(tx domain.Tx, id string, limitRange domain.LimitRange) error {
+	return n.db.atomic(tx, func(tx *memoryTx) error {
+		entity, found := tx.get(id, memoryNamespace)
+		if !found {
+			return fmt.Errorf("cannot find namespace %s", id)
+		}
+		entity.limitRange = limitRange.Clone()
+		return nil
+	})
+}
+
+func (n *namespaceMemoryStore) SetCountQuotas(tx domain.Tx, id string, countQuotas domain.CountQuotas) error {
+	return n.db.atomic(tx, func(tx *memoryTx) error {
+		entity, found := tx.get(id, memoryNamespace)
+		if !found {
+			return fmt.Errorf("cannot find namespace %s", id)
+		}
+		entity.countQuotas = countQuotas
+		return nil
+	})
+}
+
+func (n *namespaceMemoryStore) SetUtilizationThresholds(tx domain.Tx, id string, thresholds domain.UtilizationThresholds) error {
+	return n.db.atomic(tx, func(tx *memoryTx) error {
+		entity, found := tx.get(id, memoryNamespace)
+		if !found {
+			return fmt.Errorf("cannot find namespace %s", id)
+		}
+		entity.thresholds = maps.Clone(thresholds)
+		return nil
+	})
+}
+
+func (n *namespaceMemoryStore) GetCounts(tx domain.Tx, id string) (domain.ObjectCounts, error) {
+	var counts domain.ObjectCounts
+	err := n.db.read(tx, func(tx *memoryTx) error {
+		if _, found := tx.get(id, memoryNamespace); !found {
+			return fmt.Errorf("cannot find namespace %s", id)
+		}
+		counts = tx.counts(id)
+		return nil
+	})
+	if err != nil {
+		return domain.ObjectCounts{}, err
+	}
+	return counts, nil
+}
+
+func (n *namespaceMemoryStore) Remove(tx domain.Tx, id string) error {
+	return n.db.atomic(tx, func(tx *memoryTx) error {
+		if _, found := tx.get(id, memoryNamespace); found {
+			// reservations cannot outlive their namespace
+			for _, reservation := range tx.children(id, memoryReservation) {
+				tx.remove(reservation.id)
+			}
+			tx.remove(id)
+		}
+		return nil
+	})
+}
+
+func (n *namespaceMemoryStore) get(tx *memoryTx, id string) (domain.Namespace, error) {
+	entity, found := tx.get(id, memoryNamespace)
+	if !found {
+		return domain.Namespace{}, fmt.Errorf("cannot find namespace %s", id)
+	}
+	return tx.toNamespace(entity)
+}
+
+func (n *namespaceMemoryStore) populateTree(tx *memoryTx, node *domain.NamespaceTreeNode) error {
+	for _, appEntity := range tx.children(node.Namespace.GetId(), memoryApp) {
+		node.Apps = append(node.Apps, toApp(*node.Namespace, appEntity))
+	}
+	for _, childEntity := range tx.children(node.Namespace.GetId(), memoryNamespace) {
+		child, err := tx.toNamespace(childEntity)
+		if err != nil {
+			return err
+		}
+		childNode := &domain.NamespaceTreeNode{Namespace: &child}
+		err = n.populateTree(tx, childNode)
+		if err != nil {
+			return err
+		}
+		node.Children = append(node.Children, childNode)
+	}
+	return nil
+}
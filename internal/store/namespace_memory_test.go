@@ -0,0 +1,123 @@
+package store
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+func TestNamespaceMemoryStoreMove(t *testing.T) {
+	a := domain.NewNamespace("org", "a", "", nil)
+	b := domain.NewNamespace("org", "b", "", nil)
+	c := domain.NewNamespace("org", "c", "", nil)
+	missing := domain.NewNamespace("org", "missing", "", nil)
+	tests := []struct {
+		name       string
+		id         string
+		parent     *domain.Namespace
+		wantParent string
+		wantErr    bool
+	}{
+		{name: "to another parent", id: c.GetId(), parent: &b, wantParent: b.GetId()},
+		{name: "to the top level", id: c.GetId(), wantParent: ""},
+		{name: "missing parent", id: c.GetId(), parent: &missing, wantErr: true},
+		{name: "missing namespace", id: missing.GetId(), parent: &a, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespaces := NewNamespaceMemoryStore(NewMemoryDb())
+			for _, add := range []struct {
+				namespace domain.Namespace
+				parent    *domain.Namespace
+			}{{a, nil}, {b, &a}, {c, &a}} {
+				if err := namespaces.Add(nil, add.namespace, add.parent); err != nil {
+					t.Fatalf("Add(%s) error = %v", add.namespace.GetId(), err)
+				}
+			}
+			err := namespaces.Move(nil, tt.id, tt.parent)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Move() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			parent, err := namespaces.GetParent(nil, tt.id)
+			if err != nil {
+				t.Fatalf("GetParent() error = %v", err)
+			}
+			gotParent := ""
+			if parent != nil {
+				gotParent = parent.GetId()
+			}
+			if gotParent != tt.wantParent {
+				t.Errorf("parent = %q, want %q", gotParent, tt.wantParent)
+			}
+			// the namespace is no longer a child of its old parent
+			tree, err := namespaces.GetHierarchy(nil, a.GetId())
+			if err != nil {
+				t.Fatalf("GetHierarchy() error = %v", err)
+			}
+			if got := tree.Contains(tt.id); got != (tt.wantParent != "") {
+				t.Errorf("tree of %s contains %s = %v", a.GetId(), tt.id, got)
+			}
+		})
+	}
+}
+
+func TestNamespaceMemoryStoreList(t *testing.T) {
+	namespaces := NewNamespaceMemoryStore(NewMemoryDb())
+	for _, namespace := range []domain.Namespace{
+		domain.NewNamespace("org", "a", "", map[string]string{"env": "prod", "team": "payments"}),
+		domain.NewNamespace("org", "b", "", map[string]string{"env": "staging"}),
+		domain.NewNamespace("org", "c", "", map[string]string{"env": "prod"}),
+		domain.NewNamespace("org", "d", "", nil),
+		domain.NewNamespace("other", "e", "", map[string]string{"env": "prod"}),
+	} {
+		if err := namespaces.Add(nil, namespace, nil); err != nil {
+			t.Fatalf("Add(%s) error = %v", namespace.GetId(), err)
+		}
+	}
+	tests := []struct {
+		name     string
+		selector string
+		after    string
+		limit    int
+		want     []string
+	}{
+		{name: "all namespaces of the org", limit: 10, want: []string{"a", "b", "c", "d"}},
+		{name: "equals", selector: "env=prod", limit: 10, want: []string{"a", "c"}},
+		{name: "not equals matches missing labels", selector: "env!=prod", limit: 10, want: []string{"b", "d"}},
+		{name: "exists", selector: "team", limit: 10, want: []string{"a"}},
+		{name: "does not exist", selector: "!env", limit: 10, want: []string{"d"}},
+		{name: "all requirements", selector: "env in (prod,staging),!team", limit: 10, want: []string{"b", "c"}},
+		{name: "first page", limit: 2, want: []string{"a", "b"}},
+		{name: "next page", after: domain.MakeNamespaceId("org", "b"), limit: 2, want: []string{"c", "d"}},
+		{name: "last page", after: domain.MakeNamespaceId("org", "d"), limit: 2, want: []string{}},
+		{name: "page of selected namespaces", selector: "env", after: domain.MakeNamespaceId("org", "a"), limit: 1, want: []string{"b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := domain.ParseLabelSelector(tt.selector)
+			if err != nil {
+				t.Fatalf("ParseLabelSelector(%q) error = %v", tt.selector, err)
+			}
+			listed, err := namespaces.List(nil, domain.NamespaceQuery{
+				OrgId:    "org",
+				Selector: selector,
+				After:    tt.after,
+				Limit:    tt.limit,
+			})
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			got := make([]string, 0)
+			for _, namespace := range listed {
+				got = append(got, namespace.GetName())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,9 @@
 package store
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/c12s/meridian/internal/domain"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
@@ -28,99 +28,282 @@ func NewNamespaceNeo4jStore(driver neo4j.Driver, dbName string, quotas domain.Re
 	}
 }
 
-func (n *namespaceNeo4jStore) Add(namespace domain.Namespace, parent *domain.Namespace) error {
-	session := startSession(n.driver, n.dbName)
-	defer endSession(session)
-	tx, err := session.BeginTransaction()
-	if err != nil {
-		return err
-	}
-
-	_, err = tx.Run(addNamespaceCypher, map[string]any{
-		"id":              namespace.GetId(),
-		"org_id":          namespace.GetOrgId(),
-		"name":            namespace.GetName(),
-		"profile_version": namespace.GetProfileVersion(),
-		"labels":          namespace.GetLabelsJson(),
-	})
-	if err != nil {
-		tx.Rollback()
-		return err
-	}
-
-	if parent != nil {
-		_, err = tx.Run(connectNamespacesCypher, map[string]any{
-			"parent_id": parent.GetId(),
-			"child_id":  namespace.GetId(),
+func (n *namespaceNeo4jStore) Add(tx domain.Tx, namespace domain.Namespace, parent *domain.Namespace) error {
+	return atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		_, err := tx.Run(addNamespaceCypher, map[string]any{
+			"id":              namespace.GetId(),
+			"org_id":          namespace.GetOrgId(),
+			"name":            namespace.GetName(),
+			"profile_version": namespace.GetProfileVersion(),
+			"labels":          labelProperties(namespace.GetLabels()),
 		})
 		if err != nil {
-			tx.Rollback()
 			return err
 		}
-	}
 
-	err = n.quotas.SetResourceQuotas(namespace.GetId(), namespace.GetResourceQuotas(), tx)
-	if err != nil {
-		tx.Rollback()
-		return err
-	}
-	tx.Commit()
-	return nil
+		if parent != nil {
+			_, err = tx.Run(connectNamespacesCypher, map[string]any{
+				"parent_id": parent.GetId(),
+				"child_id":  namespace.GetId(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return n.quotas.SetResourceQuotas(tx, namespace.GetId(), namespace.GetResourceQuotas())
+	})
 }
 
-func (n *namespaceNeo4jStore) Get(id string) (domain.Namespace, error) {
-	session := startSession(n.driver, n.dbName)
-	defer endSession(session)
-	tx, err := session.BeginTransaction()
-	if err != nil {
-		return domain.Namespace{}, err
-	}
-	defer tx.Commit()
-	namespace, err := n.get(tx, id)
+func (n *namespaceNeo4jStore) Get(tx domain.Tx, id string) (domain.Namespace, error) {
+	var namespace domain.Namespace
+	err := atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		var err error
+		namespace, err = n.get(tx, id)
+		return err
+	})
 	if err != nil {
 		return domain.Namespace{}, err
 	}
 	return namespace, nil
 }
 
-func (n *namespaceNeo4jStore) GetHierarchy(rootId string) (domain.NamespaceTree, error) {
-	session := startSession(n.driver, n.dbName)
-	defer endSession(session)
-	tx, err := session.BeginTransaction()
+func (n *namespaceNeo4jStore) GetHierarchy(tx domain.Tx, rootId string) (domain.NamespaceTree, error) {
+	var tree domain.NamespaceTree
+	err := atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		root, err := n.get(tx, rootId)
+		if err != nil {
+			return err
+		}
+		rootNode := &domain.NamespaceTreeNode{Namespace: &root}
+		err = n.populateTree(tx, rootNode)
+		if err != nil {
+			return err
+		}
+		tree = domain.NamespaceTree{Root: *rootNode}
+		return nil
+	})
 	if err != nil {
 		return domain.NamespaceTree{}, err
 	}
-	defer tx.Commit()
-	root, err := n.get(tx, rootId)
+	return tree, nil
+}
+
+func (n *namespaceNeo4jStore) List(tx domain.Tx, query domain.NamespaceQuery) ([]domain.Namespace, error) {
+	var namespaces []domain.Namespace
+	err := atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		cypher, params := listNamespacesCypher(query)
+		res, err := tx.Run(cypher, params)
+		if err != nil {
+			return err
+		}
+		namespaces, err = n.readNamespaces(res, query.OrgId)
+		if err != nil {
+			return err
+		}
+		for i := range namespaces {
+			err = n.populateUsage(tx, &namespaces[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		tx.Rollback()
-		return domain.NamespaceTree{}, err
+		return nil, err
 	}
-	rootNode := &domain.NamespaceTreeNode{Namespace: &root}
-	err = n.populateTree(tx, rootNode)
+	return namespaces, nil
+}
+
+func (n *namespaceNeo4jStore) GetParent(tx domain.Tx, id string) (*domain.Namespace, error) {
+	var parent *domain.Namespace
+	err := atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(getParentNamespaceCypher, map[string]any{
+			"id": id,
+		})
+		if err != nil {
+			return err
+		}
+		namespaces, err := n.readNamespaces(res, id)
+		if err != nil {
+			return err
+		}
+		if len(namespaces) == 0 {
+			return nil
+		}
+		err = n.populateUsage(tx, &namespaces[0])
+		if err != nil {
+			return err
+		}
+		parent = &namespaces[0]
+		return nil
+	})
 	if err != nil {
-		tx.Rollback()
-		return domain.NamespaceTree{}, err
+		return nil, err
 	}
-	return domain.NamespaceTree{Root: *rootNode}, nil
+	return parent, nil
 }
 
-func (n *namespaceNeo4jStore) Remove(id string) error {
-	session := startSession(n.driver, n.dbName)
-	defer endSession(session)
-	tx, err := session.BeginTransaction()
-	if err != nil {
+func (n *namespaceNeo4jStore) Move(tx domain.Tx, id string, parent *domain.Namespace) error {
+	return atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		if parent == nil {
+			_, err := tx.Run(detachNamespaceCypher, map[string]any{
+				"id": id,
+			})
+			return err
+		}
+		res, err := tx.Run(moveNamespaceCypher, map[string]any{
+			"id":        id,
+			"parent_id": parent.GetId(),
+		})
+		if err != nil {
+			return err
+		}
+		summary, err := res.Consume()
+		if err != nil {
+			return err
+		}
+		if summary.Counters().RelationshipsCreated() == 0 {
+			return fmt.Errorf("cannot move namespace %s to %s", id, parent.GetId())
+		}
+		return nil
+	})
+}
+
+func (n *namespaceNeo4jStore) SetLabels(tx domain.Tx, id string, labels map[string]string) error {
+	return atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(getNamespaceCypher, map[string]any{
+			"id": id,
+		})
+		if err != nil {
+			return err
+		}
+		namespaces, err := n.readNamespaces(res, id)
+		if err != nil {
+			return err
+		}
+		if len(namespaces) == 0 {
+			return fmt.Errorf("cannot find namespace %s", id)
+		}
+		properties := labelProperties(labels)
+		for key := range namespaces[0].GetLabels() {
+			if _, found := labels[key]; !found {
+				// setting a property to null removes it
+				properties[labelPropertyPrefix+key] = nil
+			}
+		}
+		_, err = tx.Run(setLabelsCypher, map[string]any{
+			"id":     id,
+			"labels": properties,
+		})
 		return err
-	}
-	_, err = tx.Run(removeNamespaceCypher, map[string]any{
-		"id": id,
 	})
-	if err != nil {
-		tx.Rollback()
+}
+
+func (n *namespaceNeo4jStore) SetLimitRange(tx domain.Tx, id string, limitRange domain.LimitRange) error {
+	return atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		namespace, err := n.get(tx, id)
+		if err != nil {
+			return err
+		}
+		properties := limitRangeProperties(limitRange)
+		for key := range limitRangeProperties(namespace.GetLimitRange()) {
+			if _, found := properties[key]; !found {
+				// setting a property to null removes it
+				properties[key] = nil
+			}
+		}
+		_, err = tx.Run(setEntityPropertiesCypher, map[string]any{
+			"id":         id,
+			"properties": properties,
+		})
+		return err
+	})
+}
+
+func (n *namespaceNeo4jStore) SetCountQuotas(tx domain.Tx, id string, countQuotas domain.CountQuotas) error {
+	return atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(setCountQuotasCypher, map[string]any{
+			"id":         id,
+			"properties": countQuotaProperties(countQuotas),
+		})
+		if err != nil {
+			return err
+		}
+		records, err := res.Collect()
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return fmt.Errorf("cannot find namespace %s", id)
+		}
+		return nil
+	})
+}
+
+func (n *namespaceNeo4jStore) SetUtilizationThresholds(tx domain.Tx, id string, thresholds domain.UtilizationThresholds) error {
+	return atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		namespace, err := n.get(tx, id)
+		if err != nil {
+			return err
+		}
+		properties := thresholdProperties(thresholds)
+		for key := range thresholdProperties(namespace.GetUtilizationThresholds()) {
+			if _, found := properties[key]; !found {
+				// setting a property to null removes it
+				properties[key] = nil
+			}
+		}
+		_, err = tx.Run(setEntityPropertiesCypher, map[string]any{
+			"id":         id,
+			"properties": properties,
+		})
 		return err
+	})
+}
+
+func (n *namespaceNeo4jStore) GetCounts(tx domain.Tx, id string) (domain.ObjectCounts, error) {
+	var counts domain.ObjectCounts
+	err := atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(getCountsCypher, map[string]any{
+			"id": id,
+		})
+		if err != nil {
+			return err
+		}
+		records, err := res.Collect()
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return fmt.Errorf("cannot find namespace %s", id)
+		}
+		appsAny, _ := records[0].Get("apps")
+		namespacesAny, _ := records[0].Get("namespaces")
+		apps, ok := appsAny.(int64)
+		if !ok {
+			return fmt.Errorf("namespace %s app count invalid type", id)
+		}
+		namespaces, ok := namespacesAny.(int64)
+		if !ok {
+			return fmt.Errorf("namespace %s namespace count invalid type", id)
+		}
+		counts = domain.ObjectCounts{Apps: apps, Namespaces: namespaces}
+		return nil
+	})
+	if err != nil {
+		return domain.ObjectCounts{}, err
 	}
-	tx.Commit()
-	return nil
+	return counts, nil
+}
+
+func (n *namespaceNeo4jStore) Remove(tx domain.Tx, id string) error {
+	return atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		_, err := tx.Run(removeNamespaceCypher, map[string]any{
+			"id": id,
+		})
+		return err
+	})
 }
 
 func (n *namespaceNeo4jStore) get(tx neo4j.Transaction, id string) (domain.Namespace, error) {
@@ -138,11 +321,7 @@ func (n *namespaceNeo4jStore) get(tx neo4j.Transaction, id string) (domain.Names
 	if len(namespaces) == 0 {
 		return domain.Namespace{}, fmt.Errorf("cannot find namespace %s", id)
 	}
-	available, err := n.quotas.GetAvailableResources(tx, namespaces[0].GetId())
-	if err != nil {
-		return domain.Namespace{}, err
-	}
-	err = namespaces[0].SetAvailable(available)
+	err = n.populateUsage(tx, &namespaces[0])
 	if err != nil {
 		return domain.Namespace{}, err
 	}
@@ -160,12 +339,8 @@ func (n *namespaceNeo4jStore) getChildren(tx neo4j.Transaction, namespace domain
 	if err != nil {
 		return nil, err
 	}
-	for _, namespace := range namespaces {
-		available, err := n.quotas.GetAvailableResources(tx, namespace.GetId())
-		if err != nil {
-			return nil, err
-		}
-		err = namespace.SetAvailable(available)
+	for i := range namespaces {
+		err = n.populateUsage(tx, &namespaces[i])
 		if err != nil {
 			return nil, err
 		}
@@ -173,6 +348,24 @@ func (n *namespaceNeo4jStore) getChildren(tx neo4j.Transaction, namespace domain
 	return namespaces, nil
 }
 
+// populateUsage sets the available and utilized resources of the namespace.
+func (n *namespaceNeo4jStore) populateUsage(tx neo4j.Transaction, namespace *domain.Namespace) error {
+	available, err := n.quotas.GetAvailableResources(tx, namespace.GetId())
+	if err != nil {
+		return err
+	}
+	err = namespace.SetAvailable(available)
+	if err != nil {
+		return err
+	}
+	utilized, err := n.quotas.GetUtilizedResources(tx, namespace.GetId())
+	if err != nil {
+		return err
+	}
+	namespace.SetUtilized(utilized)
+	return nil
+}
+
 func (n *namespaceNeo4jStore) populateTree(tx neo4j.Transaction, node *domain.NamespaceTreeNode) error {
 	err := n.populateTreeNode(tx, node)
 	if err != nil {
@@ -188,7 +381,7 @@ func (n *namespaceNeo4jStore) populateTree(tx neo4j.Transaction, node *domain.Na
 }
 
 func (n *namespaceNeo4jStore) populateTreeNode(tx neo4j.Transaction, node *domain.NamespaceTreeNode) error {
-	apps, err := n.apps.FindChildren(*node.Namespace)
+	apps, err := n.apps.FindChildren(tx, *node.Namespace)
 	if err != nil {
 		return err
 	}
@@ -224,63 +417,246 @@ func (n *namespaceNeo4jStore) readNamespaces(res neo4j.Result, id string) ([]dom
 		if !ok {
 			return namespaces, fmt.Errorf("namespace %s has no properties", id)
 		}
-		orgIdAny, found := properties["org_id"]
+		namespace, err := readNamespace(properties, id)
+		if err != nil {
+			return namespaces, err
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	return namespaces, nil
+}
+
+func readNamespace(properties map[string]any, id string) (domain.Namespace, error) {
+	orgIdAny, found := properties["org_id"]
+	if !found {
+		return domain.Namespace{}, fmt.Errorf("namespace %s has no org_id", id)
+	}
+	orgId, ok := orgIdAny.(string)
+	if !ok {
+		return domain.Namespace{}, fmt.Errorf("namespace %s org_id invalid type", id)
+	}
+	nameAny, found := properties["name"]
+	if !found {
+		return domain.Namespace{}, fmt.Errorf("namespace %s has no name", id)
+	}
+	name, ok := nameAny.(string)
+	if !ok {
+		return domain.Namespace{}, fmt.Errorf("namespace %s name invalid type", id)
+	}
+	profileVersionAny, found := properties["profile_version"]
+	if !found {
+		return domain.Namespace{}, fmt.Errorf("namespace %s has no profile_version", id)
+	}
+	profileVersion, ok := profileVersionAny.(string)
+	if !ok {
+		return domain.Namespace{}, fmt.Errorf(" %s profile_version invalid type", id)
+	}
+	labels := make(map[string]string)
+	for key, value := range properties {
+		labelKey, found := strings.CutPrefix(key, labelPropertyPrefix)
 		if !found {
-			return namespaces, fmt.Errorf("namespace %s has no org_id", id)
+			continue
 		}
-		orgId, ok := orgIdAny.(string)
+		labelValue, ok := value.(string)
 		if !ok {
-			return namespaces, fmt.Errorf("namespace %s org_id invalid type", id)
+			return domain.Namespace{}, fmt.Errorf("namespace %s label %s invalid type", id, labelKey)
 		}
-		nameAny, found := properties["name"]
+		labels[labelKey] = labelValue
+	}
+	namespace := domain.NewNamespace(orgId, name, profileVersion, labels)
+	namespace.SetOvercommits(readOvercommits(properties))
+	namespace.SetElasticMax(readElasticMax(properties))
+	limitRange, err := readLimitRange(properties)
+	if err != nil {
+		return domain.Namespace{}, fmt.Errorf("namespace %s %w", id, err)
+	}
+	namespace.SetLimitRange(limitRange)
+	countQuotas, err := readCountQuotas(properties)
+	if err != nil {
+		return domain.Namespace{}, fmt.Errorf("namespace %s %w", id, err)
+	}
+	namespace.SetCountQuotas(countQuotas)
+	thresholds, err := readThresholds(properties)
+	if err != nil {
+		return domain.Namespace{}, fmt.Errorf("namespace %s %w", id, err)
+	}
+	namespace.SetUtilizationThresholds(thresholds)
+	for key, quotaAny := range properties {
+		resourceName, found := strings.CutPrefix(key, quotaPropertyPrefix)
 		if !found {
-			return namespaces, fmt.Errorf("namespace %s has no name", id)
+			continue
 		}
-		name, ok := nameAny.(string)
+		if quota, ok := quotaAny.(int64); !ok {
+			log.Printf("invalid quota type for resource name %s: %v\n", resourceName, quotaAny)
+		} else {
+			if err := namespace.AddResourceQuota(resourceName, quota); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+	return namespace, nil
+}
+
+// labels are stored as separate node properties so that they can be queried
+const labelPropertyPrefix = "labels."
+
+func labelProperties(labels map[string]string) map[string]any {
+	properties := make(map[string]any)
+	for key, value := range labels {
+		properties[labelPropertyPrefix+key] = value
+	}
+	return properties
+}
+
+// limit ranges are stored as node properties such as limits.max.cpu and limits.max_ratio.mem.cpu,
+// resource names cannot contain dots
+const (
+	limitPropertyPrefix         = "limits."
+	limitDefaultPropertyPrefix  = "limits.default."
+	limitMinPropertyPrefix      = "limits.min."
+	limitMaxPropertyPrefix      = "limits.max."
+	limitMaxRatioPropertyPrefix = "limits.max_ratio."
+)
+
+func limitRangeProperties(limitRange domain.LimitRange) map[string]any {
+	properties := make(map[string]any)
+	for resource, quota := range limitRange.Defaults {
+		properties[limitDefaultPropertyPrefix+resource] = quota
+	}
+	for resource, quota := range limitRange.Min {
+		properties[limitMinPropertyPrefix+resource] = quota
+	}
+	for resource, quota := range limitRange.Max {
+		properties[limitMaxPropertyPrefix+resource] = quota
+	}
+	for _, ratio := range limitRange.MaxRatios {
+		properties[limitMaxRatioPropertyPrefix+ratio.Resource+"."+ratio.PerResource] = ratio.Max
+	}
+	return properties
+}
+
+func readLimitRange(properties map[string]any) (domain.LimitRange, error) {
+	limitRange := domain.NewLimitRange()
+	for key, value := range properties {
+		if !strings.HasPrefix(key, limitPropertyPrefix) {
+			continue
+		}
+		quota, ok := value.(int64)
 		if !ok {
-			return namespaces, fmt.Errorf("namespace %s name invalid type", id)
+			return domain.LimitRange{}, fmt.Errorf("limit %s invalid type", key)
 		}
-		profileVersionAny, found := properties["profile_version"]
-		if !found {
-			return namespaces, fmt.Errorf("namespace %s has no profile_version", id)
+		// max_ratio has to be checked before max, which is its prefix
+		if ratio, found := strings.CutPrefix(key, limitMaxRatioPropertyPrefix); found {
+			resource, perResource, found := strings.Cut(ratio, ".")
+			if !found {
+				return domain.LimitRange{}, fmt.Errorf("limit %s invalid name", key)
+			}
+			limitRange.MaxRatios = append(limitRange.MaxRatios, domain.ResourceRatio{
+				Resource:    resource,
+				PerResource: perResource,
+				Max:         quota,
+			})
+		} else if resource, found := strings.CutPrefix(key, limitDefaultPropertyPrefix); found {
+			limitRange.Defaults[resource] = quota
+		} else if resource, found := strings.CutPrefix(key, limitMinPropertyPrefix); found {
+			limitRange.Min[resource] = quota
+		} else if resource, found := strings.CutPrefix(key, limitMaxPropertyPrefix); found {
+			limitRange.Max[resource] = quota
+		}
+	}
+	return limitRange, nil
+}
+
+// utilization thresholds are stored in per mille as node properties such as thresholds.warning.cpu
+const (
+	thresholdPropertyPrefix         = "thresholds."
+	thresholdWarningPropertyPrefix  = "thresholds.warning."
+	thresholdCriticalPropertyPrefix = "thresholds.critical."
+)
+
+func thresholdProperties(thresholds domain.UtilizationThresholds) map[string]any {
+	properties := make(map[string]any)
+	for resource, threshold := range thresholds {
+		if threshold.Warning > 0 {
+			properties[thresholdWarningPropertyPrefix+resource] = threshold.Warning
 		}
-		profileVersion, ok := profileVersionAny.(string)
+		if threshold.Critical > 0 {
+			properties[thresholdCriticalPropertyPrefix+resource] = threshold.Critical
+		}
+	}
+	return properties
+}
+
+func readThresholds(properties map[string]any) (domain.UtilizationThresholds, error) {
+	thresholds := make(domain.UtilizationThresholds)
+	for key, value := range properties {
+		if !strings.HasPrefix(key, thresholdPropertyPrefix) {
+			continue
+		}
+		perMille, ok := value.(int64)
 		if !ok {
-			return namespaces, fmt.Errorf(" %s profile_version invalid type", id)
+			return nil, fmt.Errorf("threshold %s invalid type", key)
 		}
-		labelsAny, found := properties["labels"]
-		if !found {
-			return namespaces, fmt.Errorf("namespace %s has no labels", id)
+		if resource, found := strings.CutPrefix(key, thresholdWarningPropertyPrefix); found {
+			threshold := thresholds[resource]
+			threshold.Warning = perMille
+			thresholds[resource] = threshold
+		} else if resource, found := strings.CutPrefix(key, thresholdCriticalPropertyPrefix); found {
+			threshold := thresholds[resource]
+			threshold.Critical = perMille
+			thresholds[resource] = threshold
 		}
-		labelsJson, ok := labelsAny.(string)
+	}
+	return thresholds, nil
+}
+
+const (
+	maxAppsProperty       = "count_quota.apps"
+	maxNamespacesProperty = "count_quota.namespaces"
+)
+
+// countQuotaProperties removes the properties of count quotas that are not limited.
+func countQuotaProperties(countQuotas domain.CountQuotas) map[string]any {
+	properties := map[string]any{
+		maxAppsProperty:       nil,
+		maxNamespacesProperty: nil,
+	}
+	if countQuotas.MaxApps > 0 {
+		properties[maxAppsProperty] = countQuotas.MaxApps
+	}
+	if countQuotas.MaxNamespaces > 0 {
+		properties[maxNamespacesProperty] = countQuotas.MaxNamespaces
+	}
+	return properties
+}
+
+func readCountQuotas(properties map[string]any) (domain.CountQuotas, error) {
+	countQuotas := domain.CountQuotas{}
+	if maxAppsAny, found := properties[maxAppsProperty]; found {
+		maxApps, ok := maxAppsAny.(int64)
 		if !ok {
-			return namespaces, fmt.Errorf("namespace %s labels invalid type", id)
+			return domain.CountQuotas{}, fmt.Errorf("%s invalid type", maxAppsProperty)
 		}
-		labels := make(map[string]string)
-		err = json.Unmarshal([]byte(labelsJson), &labels)
-		if err != nil {
-			return namespaces, nil
-		}
-		namespace := domain.NewNamespace(orgId, name, profileVersion, labels)
-		for _, resourceName := range domain.SupportedResourceQuotas {
-			quotaAny, found := properties[resourceName]
-			if found {
-				if quota, ok := quotaAny.(float64); !ok {
-					log.Printf("invalid quota type for resource name %s: %v\n", resourceName, quotaAny)
-				} else {
-					if err := namespace.AddResourceQuota(resourceName, quota); err != nil {
-						log.Println(err)
-					}
-				}
-			}
+		countQuotas.MaxApps = maxApps
+	}
+	if maxNamespacesAny, found := properties[maxNamespacesProperty]; found {
+		maxNamespaces, ok := maxNamespacesAny.(int64)
+		if !ok {
+			return domain.CountQuotas{}, fmt.Errorf("%s invalid type", maxNamespacesProperty)
 		}
-		namespaces = append(namespaces, namespace)
+		countQuotas.MaxNamespaces = maxNamespaces
 	}
-	return namespaces, nil
+	return countQuotas, nil
 }
 
 const addNamespaceCypher = `
-CREATE (n:Namespace:Entity{id: $id, org_id: $org_id, name: $name, profile_version: $profile_version, labels: $labels});
+CREATE (n:Namespace:Entity{id: $id, org_id: $org_id, name: $name, profile_version: $profile_version})
+SET n += $labels;
+`
+
+const setLabelsCypher = `
+MATCH (n:Namespace{id: $id})
+SET n += $labels;
 `
 
 const connectNamespacesCypher = `
@@ -289,9 +665,23 @@ MATCH (c:Namespace{id: $child_id})
 CREATE (p)-[:CHILD]->(c);
 `
 
+const moveNamespaceCypher = `
+MATCH (n:Namespace{id: $id})
+MATCH (p:Namespace{id: $parent_id})
+OPTIONAL MATCH (:Namespace)-[r:CHILD]->(n)
+DELETE r
+CREATE (p)-[:CHILD]->(n);
+`
+
+const detachNamespaceCypher = `
+MATCH (:Namespace)-[r:CHILD]->(n:Namespace{id: $id})
+DELETE r;
+`
+
 const removeNamespaceCypher = `
 MATCH (n:Namespace{id: $id})
-DETACH DELETE n;
+OPTIONAL MATCH (n)-[:CHILD]->(r:Reservation)
+DETACH DELETE r, n;
 `
 
 const getNamespaceCypher = `
@@ -299,8 +689,65 @@ MATCH (n:Namespace{id: $id})
 RETURN properties(n) AS properties;
 `
 
+func listNamespacesCypher(query domain.NamespaceQuery) (string, map[string]any) {
+	conditions := []string{"n.id > $after"}
+	params := map[string]any{
+		"org_id": query.OrgId,
+		"after":  query.After,
+		"limit":  query.Limit,
+	}
+	for i, requirement := range query.Selector {
+		key := fmt.Sprintf("key_%d", i)
+		values := fmt.Sprintf("values_%d", i)
+		params[key] = labelPropertyPrefix + requirement.Key
+		if requirement.Values != nil {
+			params[values] = requirement.Values
+		}
+		var condition string
+		switch requirement.Operator {
+		case domain.LabelEquals, domain.LabelIn:
+			condition = "n[$%[1]s] IN $%[2]s"
+		case domain.LabelNotEquals, domain.LabelNotIn:
+			condition = "(n[$%[1]s] IS NULL OR NOT n[$%[1]s] IN $%[2]s)"
+		case domain.LabelExists:
+			condition = "n[$%[1]s] IS NOT NULL"
+		case domain.LabelDoesNotExist:
+			condition = "n[$%[1]s] IS NULL"
+		}
+		conditions = append(conditions, fmt.Sprintf(condition, key, values))
+	}
+	return fmt.Sprintf(`
+MATCH (n:Namespace{org_id: $org_id})
+WHERE %s
+WITH n
+ORDER BY n.id
+LIMIT $limit
+RETURN properties(n) AS properties;
+`, strings.Join(conditions, " AND ")), params
+}
+
+const getParentNamespaceCypher = `
+MATCH (n:Namespace{id: $id})
+OPTIONAL MATCH (p:Namespace)-[:CHILD]->(n)
+RETURN properties(p) AS properties;
+`
+
 const getChildNamespacesCypher = `
 MATCH (n:Namespace{id: $id})
 OPTIONAL MATCH (c:Namespace)<-[:CHILD]-(n)
 RETURN properties(c) AS properties;
 `
+
+const setCountQuotasCypher = `
+MATCH (n:Namespace{id: $id})
+SET n += $properties
+RETURN n.id;
+`
+
+const getCountsCypher = `
+MATCH (n:Namespace{id: $id})
+OPTIONAL MATCH (n)-[:CHILD*]->(d:Namespace)
+WITH n, count(DISTINCT d) AS namespaces
+OPTIONAL MATCH (n)-[:CHILD*0..]->(:Namespace)-[:CHILD]->(a:App)
+RETURN count(DISTINCT a) AS apps, namespaces;
+`
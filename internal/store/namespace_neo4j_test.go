@@ -0,0 +1,65 @@
+package store
+
+import (
+	"maps"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+func TestListNamespacesCypher(t *testing.T) {
+	tests := []struct {
+		name           string
+		selector       string
+		wantConditions string
+		wantParams     map[string]any
+	}{
+		{
+			name:           "without a selector",
+			wantConditions: "n.id > $after",
+			wantParams:     map[string]any{},
+		},
+		{
+			name:           "equals",
+			selector:       "env=prod",
+			wantConditions: "n.id > $after AND n[$key_0] IN $values_0",
+			wantParams:     map[string]any{"key_0": "labels.env", "values_0": []string{"prod"}},
+		},
+		{
+			name:           "not in",
+			selector:       "env notin (prod,staging)",
+			wantConditions: "n.id > $after AND (n[$key_0] IS NULL OR NOT n[$key_0] IN $values_0)",
+			wantParams:     map[string]any{"key_0": "labels.env", "values_0": []string{"prod", "staging"}},
+		},
+		{
+			name:           "exists and does not exist",
+			selector:       "team,!env",
+			wantConditions: "n.id > $after AND n[$key_0] IS NOT NULL AND n[$key_1] IS NULL",
+			wantParams:     map[string]any{"key_0": "labels.team", "key_1": "labels.env"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := domain.ParseLabelSelector(tt.selector)
+			if err != nil {
+				t.Fatalf("ParseLabelSelector(%q) error = %v", tt.selector, err)
+			}
+			cypher, params := listNamespacesCypher(domain.NamespaceQuery{
+				OrgId:    "org",
+				Selector: selector,
+				After:    "org/a",
+				Limit:    10,
+			})
+			if !strings.Contains(cypher, "WHERE "+tt.wantConditions+"\n") {
+				t.Errorf("cypher = %s, want the conditions %s", cypher, tt.wantConditions)
+			}
+			wantParams := map[string]any{"org_id": "org", "after": "org/a", "limit": 10}
+			maps.Copy(wantParams, tt.wantParams)
+			if !reflect.DeepEqual(params, wantParams) {
+				t.Errorf("params = %v, want %v", params, wantParams)
+			}
+		})
+	}
+}
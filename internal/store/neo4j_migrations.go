@@ -0,0 +1,191 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+
+	"github.com/c12s/meridian/internal/domain"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+// neo4jMigrations bring data written by older versions of meridian up to date. The number
+// of applied migrations is stored in the MigrationVersion node, so that each of them runs
+// once. New migrations are appended and existing ones are never reordered or removed.
+// Databases written before the version was stored run all of them, so they have to be idempotent.
+var neo4jMigrations = []func(tx neo4j.Transaction) error{
+	migrateLabelsJson,
+	migrateQuotaProperties,
+	migrateFloatQuotas,
+}
+
+func MigrateNeo4j(driver neo4j.Driver, dbName string) error {
+	return atomic(driver, dbName, nil, func(tx neo4j.Transaction) error {
+		version, err := getMigrationVersion(tx)
+		if err != nil {
+			return err
+		}
+		if version >= len(neo4jMigrations) {
+			return nil
+		}
+		for _, migration := range neo4jMigrations[version:] {
+			err := migration(tx)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.Run(setMigrationVersionCypher, map[string]any{"version": len(neo4jMigrations)})
+		return err
+	})
+}
+
+// getMigrationVersion returns the number of migrations applied to the database.
+func getMigrationVersion(tx neo4j.Transaction) (int, error) {
+	res, err := tx.Run(getMigrationVersionCypher, nil)
+	if err != nil {
+		return 0, err
+	}
+	records, err := res.Collect()
+	if err != nil {
+		return 0, err
+	}
+	if len(records) == 0 {
+		return 0, nil
+	}
+	version, ok := records[0].Values[0].(int64)
+	if !ok {
+		return 0, fmt.Errorf("migration version invalid type: %v", records[0].Values[0])
+	}
+	return int(version), nil
+}
+
+// migrateLabelsJson moves namespace labels stored as a single json string to label properties.
+func migrateLabelsJson(tx neo4j.Transaction) error {
+	res, err := tx.Run(getLabelsJsonCypher, nil)
+	if err != nil {
+		return err
+	}
+	records, err := res.Collect()
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if len(record.Values) < 2 {
+			continue
+		}
+		id, ok := record.Values[0].(string)
+		if !ok {
+			return fmt.Errorf("namespace id invalid type: %v", record.Values[0])
+		}
+		labelsJson, ok := record.Values[1].(string)
+		if !ok {
+			return fmt.Errorf("namespace %s labels invalid type", id)
+		}
+		labels := make(map[string]string)
+		err = json.Unmarshal([]byte(labelsJson), &labels)
+		if err != nil {
+			log.Printf("namespace %s has invalid labels %s: %v\n", id, labelsJson, err)
+		}
+		_, err = tx.Run(replaceLabelsJsonCypher, map[string]any{
+			"id":     id,
+			"labels": labelProperties(labels),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateFloatQuotas converts quotas stored as floating point numbers of units to integer milli units.
+// Only the quota properties are converted, other floating point properties are kept.
+func migrateFloatQuotas(tx neo4j.Transaction) error {
+	res, err := tx.Run(getEntityPropertiesCypher, nil)
+	if err != nil {
+		return err
+	}
+	records, err := res.Collect()
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		if len(record.Values) < 2 {
+			continue
+		}
+		id, ok := record.Values[0].(string)
+		if !ok {
+			return fmt.Errorf("entity id invalid type: %v", record.Values[0])
+		}
+		properties, ok := record.Values[1].(map[string]any)
+		if !ok {
+			return fmt.Errorf("entity %s has no properties", id)
+		}
+		quotas := make(map[string]any)
+		for key, value := range properties {
+			if quota, ok := value.(float64); ok && strings.HasPrefix(key, quotaPropertyPrefix) {
+				quotas[key] = int64(math.Round(quota * domain.MilliUnits))
+			}
+		}
+		if len(quotas) == 0 {
+			continue
+		}
+		_, err = tx.Run(setEntityPropertiesCypher, map[string]any{
+			"id":         id,
+			"properties": quotas,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const getMigrationVersionCypher = `
+MATCH (m:MigrationVersion)
+RETURN m.version;
+`
+
+const setMigrationVersionCypher = `
+MERGE (m:MigrationVersion)
+SET m.version = $version;
+`
+
+const getLabelsJsonCypher = `
+MATCH (n:Namespace)
+WHERE n.labels IS NOT NULL
+RETURN n.id, n.labels;
+`
+
+const replaceLabelsJsonCypher = `
+MATCH (n:Namespace{id: $id})
+SET n += $labels
+REMOVE n.labels;
+`
+
+// migrateQuotaProperties moves the quotas of the resources supported before resource types
+// were configurable from properties named after the resource to prefixed quota properties.
+func migrateQuotaProperties(tx neo4j.Transaction) error {
+	for _, resourceType := range domain.DefaultResourceTypes {
+		_, err := tx.Run(moveQuotaPropertyCypher(resourceType.Name), nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func moveQuotaPropertyCypher(resource string) string {
+	return fmt.Sprintf("MATCH (e:Entity) WHERE e.%[1]s IS NOT NULL SET e.`%[2]s` = e.%[1]s REMOVE e.%[1]s;", resource, quotaPropertyPrefix+resource)
+}
+
+const getEntityPropertiesCypher = `
+MATCH (e:Entity)
+RETURN e.id, properties(e);
+`
+
+const setEntityPropertiesCypher = `
+MATCH (e:Entity{id: $id})
+SET e += $properties;
+`
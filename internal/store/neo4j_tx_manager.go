@@ -1,11 +1,61 @@
 package store
 
 import (
+	"fmt"
 	"log"
 
+	"github.com/c12s/meridian/internal/domain"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
 
+type neo4jTxManager struct {
+	driver neo4j.Driver
+	dbName string
+}
+
+func NewNeo4jTxManager(driver neo4j.Driver, dbName string) domain.TxManager {
+	if driver == nil {
+		log.Fatalln("driver is nil while initializing neo4j tx manager")
+	}
+	return &neo4jTxManager{
+		driver: driver,
+		dbName: dbName,
+	}
+}
+
+func (m *neo4jTxManager) Atomic(fn func(tx domain.Tx) error) error {
+	return atomic(m.driver, m.dbName, nil, func(tx neo4j.Transaction) error {
+		return fn(tx)
+	})
+}
+
+// atomic runs fn in tx if one is given. Otherwise it begins a new transaction,
+// commits it if fn succeeds and rolls it back if it fails.
+func atomic(driver neo4j.Driver, dbName string, tx domain.Tx, fn func(tx neo4j.Transaction) error) error {
+	if tx != nil {
+		neo4jTx, ok := tx.(neo4j.Transaction)
+		if !ok {
+			return fmt.Errorf("transaction of type %T is not a neo4j transaction", tx)
+		}
+		return fn(neo4jTx)
+	}
+
+	session := startSession(driver, dbName)
+	defer endSession(session)
+	newTx, err := session.BeginTransaction()
+	if err != nil {
+		return err
+	}
+	err = fn(newTx)
+	if err != nil {
+		if err := newTx.Rollback(); err != nil {
+			log.Println(err)
+		}
+		return err
+	}
+	return newTx.Commit()
+}
+
 func startSession(driver neo4j.Driver, dbName string) neo4j.Session {
 	return driver.NewSession(neo4j.SessionConfig{DatabaseName: dbName, AccessMode: neo4j.AccessModeWrite})
 }
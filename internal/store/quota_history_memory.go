@@ -0,0 +1,89 @@
+package store
+
+import (
+	"log"
+	"slices"
+	"time"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+type quotaHistoryMemoryStore struct {
+	db *MemoryDb
+}
+
+func NewQuotaHistoryMemoryStore(db *MemoryDb) domain.QuotaHistoryStore {
+	if db == nil {
+		log.Fatalln("db is nil while initializing quota history memory store")
+	}
+	return &quotaHistoryMemoryStore{
+		db: db,
+	}
+}
+
+func (q *quotaHistoryMemoryStore) Add(tx domain.Tx, samples []domain.QuotaSample) error {
+	return q.db.atomic(tx, func(tx *memoryTx) error {
+		tx.history = append(tx.history, samples...)
+		return nil
+	})
+}
+
+func (q *quotaHistoryMemoryStore) List(tx domain.Tx, query domain.QuotaHistoryQuery) ([]domain.QuotaSample, error) {
+	var samples []domain.QuotaSample
+	err := q.db.read(tx, func(tx *memoryTx) error {
+		samples = tx.listSamples(query.Matches, query.From, query.To)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return samples, nil
+}
+
+func (q *quotaHistoryMemoryStore) ListSubtree(tx domain.Tx, namespaceId string, from, to time.Time) ([]domain.QuotaSample, error) {
+	var samples []domain.QuotaSample
+	err := q.db.read(tx, func(tx *memoryTx) error {
+		subtree := make(map[string]bool)
+		pending := []string{namespaceId}
+		for len(pending) > 0 {
+			id := pending[0]
+			pending = pending[1:]
+			subtree[id] = true
+			for _, child := range tx.children(id, memoryNamespace) {
+				pending = append(pending, child.id)
+			}
+		}
+		samples = tx.listSamples(func(sample domain.QuotaSample) bool {
+			return subtree[sample.EntityId]
+		}, from, to)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return samples, nil
+}
+
+// listSamples returns the matching samples within the range and, for each entity and resource,
+// the latest matching sample before the range, in the order they were recorded.
+func (tx *memoryTx) listSamples(matches func(sample domain.QuotaSample) bool, from, to time.Time) []domain.QuotaSample {
+	samples := make([]domain.QuotaSample, 0)
+	before := make(map[[2]string]domain.QuotaSample)
+	for _, sample := range tx.history {
+		if !matches(sample) || sample.Timestamp.After(to) {
+			continue
+		}
+		if sample.Timestamp.Before(from) {
+			before[[2]string{sample.EntityId, sample.Resource}] = sample
+			continue
+		}
+		samples = append(samples, sample)
+	}
+	for _, sample := range before {
+		samples = append(samples, sample)
+	}
+	slices.SortStableFunc(samples, func(a, b domain.QuotaSample) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	return samples
+}
@@ -0,0 +1,186 @@
+package store
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/c12s/meridian/internal/domain"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+type quotaHistoryNeo4jStore struct {
+	driver neo4j.Driver
+	dbName string
+}
+
+func NewQuotaHistoryNeo4jStore(driver neo4j.Driver, dbName string) domain.QuotaHistoryStore {
+	if driver == nil {
+		log.Fatalln("driver is nil while initializing quota history neo4j store")
+	}
+	return &quotaHistoryNeo4jStore{
+		driver: driver,
+		dbName: dbName,
+	}
+}
+
+func (q *quotaHistoryNeo4jStore) Add(tx domain.Tx, samples []domain.QuotaSample) error {
+	if len(samples) == 0 {
+		return nil
+	}
+	return atomic(q.driver, q.dbName, tx, func(tx neo4j.Transaction) error {
+		params := make([]map[string]any, 0, len(samples))
+		for _, sample := range samples {
+			params = append(params, map[string]any{
+				"entity_id": sample.EntityId,
+				"resource":  sample.Resource,
+				"allocated": sample.Allocated,
+				"available": sample.Available,
+				"utilized":  sample.Utilized,
+				"timestamp": sample.Timestamp.UnixMilli(),
+			})
+		}
+		_, err := tx.Run(addQuotaSamplesCypher, map[string]any{
+			"samples": params,
+		})
+		return err
+	})
+}
+
+func (q *quotaHistoryNeo4jStore) List(tx domain.Tx, query domain.QuotaHistoryQuery) ([]domain.QuotaSample, error) {
+	params := map[string]any{
+		"entity_id": query.EntityId,
+		"resources": query.Resources,
+		"from":      query.From.UnixMilli(),
+		"to":        query.To.UnixMilli(),
+	}
+	if query.Resources == nil {
+		params["resources"] = []string{}
+	}
+	return q.list(tx, params, listQuotaSamplesBeforeCypher, listQuotaSamplesCypher)
+}
+
+func (q *quotaHistoryNeo4jStore) ListSubtree(tx domain.Tx, namespaceId string, from, to time.Time) ([]domain.QuotaSample, error) {
+	params := map[string]any{
+		"id":   namespaceId,
+		"from": from.UnixMilli(),
+		"to":   to.UnixMilli(),
+	}
+	return q.list(tx, params, listSubtreeQuotaSamplesBeforeCypher, listSubtreeQuotaSamplesCypher)
+}
+
+// list runs the queries of the samples before and within a range and merges their results.
+func (q *quotaHistoryNeo4jStore) list(tx domain.Tx, params map[string]any, cyphers ...string) ([]domain.QuotaSample, error) {
+	samples := make([]domain.QuotaSample, 0)
+	err := atomic(q.driver, q.dbName, tx, func(tx neo4j.Transaction) error {
+		for _, cypher := range cyphers {
+			res, err := tx.Run(cypher, params)
+			if err != nil {
+				return err
+			}
+			read, err := q.readQuotaSamples(res)
+			if err != nil {
+				return err
+			}
+			samples = append(samples, read...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(samples, func(a, b domain.QuotaSample) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	return samples, nil
+}
+
+func (q *quotaHistoryNeo4jStore) readQuotaSamples(res neo4j.Result) ([]domain.QuotaSample, error) {
+	samples := make([]domain.QuotaSample, 0)
+	if res.Err() != nil {
+		return samples, res.Err()
+	}
+	records, err := res.Collect()
+	if err != nil {
+		return samples, err
+	}
+	for _, record := range records {
+		propertiesAny, found := record.Get("properties")
+		if !found {
+			return samples, fmt.Errorf("quota sample has no properties")
+		}
+		properties, ok := propertiesAny.(map[string]any)
+		if !ok {
+			return samples, fmt.Errorf("quota sample has no properties")
+		}
+		entityId, ok := properties["entity_id"].(string)
+		if !ok {
+			return samples, fmt.Errorf("quota sample entity_id invalid type")
+		}
+		resource, ok := properties["resource"].(string)
+		if !ok {
+			return samples, fmt.Errorf("quota sample of %s resource invalid type", entityId)
+		}
+		values := make(map[string]int64)
+		for _, key := range []string{"allocated", "available", "utilized", "timestamp"} {
+			value, ok := properties[key].(int64)
+			if !ok {
+				return samples, fmt.Errorf("quota sample of %s %s invalid type", entityId, key)
+			}
+			values[key] = value
+		}
+		samples = append(samples, domain.QuotaSample{
+			EntityId:  entityId,
+			Resource:  resource,
+			Allocated: values["allocated"],
+			Available: values["available"],
+			Utilized:  values["utilized"],
+			Timestamp: fromUnixMilli(values["timestamp"]),
+		})
+	}
+	return samples, nil
+}
+
+// samples are not entities, they are kept after the entities are removed
+const addQuotaSamplesCypher = `
+UNWIND $samples AS sample
+CREATE (s:QuotaSample)
+SET s = sample;
+`
+
+const listQuotaSamplesCypher = `
+MATCH (s:QuotaSample{entity_id: $entity_id})
+WHERE (size($resources) = 0 OR s.resource IN $resources) AND s.timestamp >= $from AND s.timestamp <= $to
+RETURN properties(s) AS properties
+ORDER BY s.timestamp;
+`
+
+const listQuotaSamplesBeforeCypher = `
+MATCH (s:QuotaSample{entity_id: $entity_id})
+WHERE (size($resources) = 0 OR s.resource IN $resources) AND s.timestamp < $from
+WITH s.resource AS resource, max(s.timestamp) AS timestamp
+MATCH (s:QuotaSample{entity_id: $entity_id, resource: resource, timestamp: timestamp})
+RETURN properties(s) AS properties
+ORDER BY s.timestamp;
+`
+
+const listSubtreeQuotaSamplesCypher = `
+MATCH (:Namespace{id: $id})-[:CHILD*0..]->(n:Namespace)
+WITH collect(n.id) AS ids
+MATCH (s:QuotaSample)
+WHERE s.entity_id IN ids AND s.timestamp >= $from AND s.timestamp <= $to
+RETURN properties(s) AS properties
+ORDER BY s.timestamp;
+`
+
+const listSubtreeQuotaSamplesBeforeCypher = `
+MATCH (:Namespace{id: $id})-[:CHILD*0..]->(n:Namespace)
+WITH collect(n.id) AS ids
+MATCH (s:QuotaSample)
+WHERE s.entity_id IN ids AND s.timestamp < $from
+WITH s.entity_id AS entity_id, s.resource AS resource, max(s.timestamp) AS timestamp
+MATCH (s:QuotaSample{entity_id: entity_id, resource: resource, timestamp: timestamp})
+RETURN properties(s) AS properties
+ORDER BY s.timestamp;
+`
@@ -0,0 +1,86 @@
+package store
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+type quotaRequestMemoryStore struct {
+	db *MemoryDb
+}
+
+func NewQuotaRequestMemoryStore(db *MemoryDb) domain.QuotaRequestStore {
+	if db == nil {
+		log.Fatalln("db is nil while initializing quota request memory store")
+	}
+	return &quotaRequestMemoryStore{
+		db: db,
+	}
+}
+
+func (q *quotaRequestMemoryStore) Add(tx domain.Tx, request domain.QuotaRequest) error {
+	return q.db.atomic(tx, func(tx *memoryTx) error {
+		if _, found := tx.quotaRequests[request.GetId()]; found {
+			return fmt.Errorf("quota request %s already exists", request.GetId())
+		}
+		tx.quotaRequests[request.GetId()] = request
+		return nil
+	})
+}
+
+func (q *quotaRequestMemoryStore) Get(tx domain.Tx, id string) (domain.QuotaRequest, error) {
+	var request domain.QuotaRequest
+	err := q.db.read(tx, func(tx *memoryTx) error {
+		var found bool
+		request, found = tx.quotaRequests[id]
+		if !found {
+			return fmt.Errorf("cannot find quota request %s", id)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.QuotaRequest{}, err
+	}
+	return request, nil
+}
+
+func (q *quotaRequestMemoryStore) List(tx domain.Tx, query domain.QuotaRequestQuery) ([]domain.QuotaRequest, error) {
+	requests := make([]domain.QuotaRequest, 0)
+	err := q.db.read(tx, func(tx *memoryTx) error {
+		for _, request := range tx.quotaRequests {
+			if request.GetOrgId() != query.OrgId ||
+				(query.NamespaceId != "" && request.GetNamespaceId() != query.NamespaceId) ||
+				(query.Status != "" && request.GetStatus() != query.Status) {
+				continue
+			}
+			requests = append(requests, request)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(requests, func(a, b domain.QuotaRequest) int {
+		if c := a.GetCreatedAt().Compare(b.GetCreatedAt()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.GetId(), b.GetId())
+	})
+	return requests, nil
+}
+
+func (q *quotaRequestMemoryStore) SetReview(tx domain.Tx, request domain.QuotaRequest) error {
+	return q.db.atomic(tx, func(tx *memoryTx) error {
+		stored, found := tx.quotaRequests[request.GetId()]
+		if !found {
+			return fmt.Errorf("cannot find quota request %s", request.GetId())
+		}
+		stored.Review(request.GetStatus(), request.GetReviewer(), request.GetReviewComment(), request.GetReviewedAt())
+		tx.quotaRequests[request.GetId()] = stored
+		return nil
+	})
+}
@@ -0,0 +1,207 @@
+package store
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/c12s/meridian/internal/domain"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+type quotaRequestNeo4jStore struct {
+	driver neo4j.Driver
+	dbName string
+}
+
+func NewQuotaRequestNeo4jStore(driver neo4j.Driver, dbName string) domain.QuotaRequestStore {
+	if driver == nil {
+		log.Fatalln("driver is nil while initializing quota request neo4j store")
+	}
+	return &quotaRequestNeo4jStore{
+		driver: driver,
+		dbName: dbName,
+	}
+}
+
+func (q *quotaRequestNeo4jStore) Add(tx domain.Tx, request domain.QuotaRequest) error {
+	return atomic(q.driver, q.dbName, tx, func(tx neo4j.Transaction) error {
+		properties := map[string]any{
+			"org_id":         request.GetOrgId(),
+			"namespace_id":   request.GetNamespaceId(),
+			"namespace_name": request.GetNamespaceName(),
+			"requester":      request.GetRequester(),
+			"justification":  request.GetJustification(),
+			"created_at":     request.GetCreatedAt().UnixMilli(),
+			"status":         string(request.GetStatus()),
+		}
+		// quota requests are not entities, so their desired quotas do not count as utilized
+		maps.Copy(properties, quotaProperties(request.GetResourceQuotas()))
+		_, err := tx.Run(addQuotaRequestCypher, map[string]any{
+			"id":         request.GetId(),
+			"properties": properties,
+		})
+		return err
+	})
+}
+
+func (q *quotaRequestNeo4jStore) Get(tx domain.Tx, id string) (domain.QuotaRequest, error) {
+	var requests []domain.QuotaRequest
+	err := atomic(q.driver, q.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(getQuotaRequestCypher, map[string]any{
+			"id": id,
+		})
+		if err != nil {
+			return err
+		}
+		requests, err = q.readQuotaRequests(res)
+		return err
+	})
+	if err != nil {
+		return domain.QuotaRequest{}, err
+	}
+	if len(requests) == 0 {
+		return domain.QuotaRequest{}, fmt.Errorf("cannot find quota request %s", id)
+	}
+	return requests[0], nil
+}
+
+func (q *quotaRequestNeo4jStore) List(tx domain.Tx, query domain.QuotaRequestQuery) ([]domain.QuotaRequest, error) {
+	var requests []domain.QuotaRequest
+	err := atomic(q.driver, q.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(listQuotaRequestsCypher, map[string]any{
+			"org_id":       query.OrgId,
+			"namespace_id": query.NamespaceId,
+			"status":       string(query.Status),
+		})
+		if err != nil {
+			return err
+		}
+		requests, err = q.readQuotaRequests(res)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+func (q *quotaRequestNeo4jStore) SetReview(tx domain.Tx, request domain.QuotaRequest) error {
+	return atomic(q.driver, q.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(setQuotaRequestReviewCypher, map[string]any{
+			"id": request.GetId(),
+			"properties": map[string]any{
+				"status":         string(request.GetStatus()),
+				"reviewer":       request.GetReviewer(),
+				"review_comment": request.GetReviewComment(),
+				"reviewed_at":    request.GetReviewedAt().UnixMilli(),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		records, err := res.Collect()
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return fmt.Errorf("cannot find quota request %s", request.GetId())
+		}
+		return nil
+	})
+}
+
+func (q *quotaRequestNeo4jStore) readQuotaRequests(res neo4j.Result) ([]domain.QuotaRequest, error) {
+	requests := make([]domain.QuotaRequest, 0)
+	if res.Err() != nil {
+		return requests, res.Err()
+	}
+	records, err := res.Collect()
+	if err != nil {
+		return requests, err
+	}
+	for _, record := range records {
+		propertiesAny, found := record.Get("properties")
+		if !found {
+			return requests, fmt.Errorf("quota request has no properties")
+		}
+		properties, ok := propertiesAny.(map[string]any)
+		if !ok {
+			return requests, fmt.Errorf("quota request has no properties")
+		}
+		request, err := readQuotaRequest(properties)
+		if err != nil {
+			return requests, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+func readQuotaRequest(properties map[string]any) (domain.QuotaRequest, error) {
+	strs := make(map[string]string)
+	for _, key := range []string{"id", "org_id", "namespace_name", "requester", "justification", "status"} {
+		valueAny, found := properties[key]
+		if !found {
+			return domain.QuotaRequest{}, fmt.Errorf("quota request has no %s", key)
+		}
+		value, ok := valueAny.(string)
+		if !ok {
+			return domain.QuotaRequest{}, fmt.Errorf("quota request %s invalid type", key)
+		}
+		strs[key] = value
+	}
+	// review properties are missing until the request is reviewed
+	for _, key := range []string{"reviewer", "review_comment"} {
+		if value, ok := properties[key].(string); ok {
+			strs[key] = value
+		}
+	}
+	createdAt, ok := properties["created_at"].(int64)
+	if !ok {
+		return domain.QuotaRequest{}, fmt.Errorf("quota request created_at invalid type")
+	}
+	var reviewedAt time.Time
+	if millis, ok := properties["reviewed_at"].(int64); ok {
+		reviewedAt = time.UnixMilli(millis)
+	}
+	quotas := make(domain.ResourceQuotas)
+	for key, value := range properties {
+		resource, found := strings.CutPrefix(key, quotaPropertyPrefix)
+		if !found {
+			continue
+		}
+		if quota, ok := value.(int64); !ok {
+			log.Printf("invalid quota type for resource name %s: %v\n", resource, value)
+		} else {
+			quotas[resource] = quota
+		}
+	}
+	return domain.RestoreQuotaRequest(strs["id"], strs["org_id"], strs["namespace_name"], strs["requester"], strs["justification"], quotas,
+		time.UnixMilli(createdAt), domain.QuotaRequestStatus(strs["status"]), strs["reviewer"], strs["review_comment"], reviewedAt), nil
+}
+
+const addQuotaRequestCypher = `
+CREATE (q:QuotaRequest{id: $id})
+SET q += $properties;
+`
+
+const getQuotaRequestCypher = `
+MATCH (q:QuotaRequest{id: $id})
+RETURN properties(q) AS properties;
+`
+
+const listQuotaRequestsCypher = `
+MATCH (q:QuotaRequest{org_id: $org_id})
+WHERE ($namespace_id = '' OR q.namespace_id = $namespace_id) AND ($status = '' OR q.status = $status)
+RETURN properties(q) AS properties
+ORDER BY q.created_at, q.id;
+`
+
+const setQuotaRequestReviewCypher = `
+MATCH (q:QuotaRequest{id: $id})
+SET q += $properties
+RETURN q.id;
+`
@@ -0,0 +1,131 @@
+package store
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+type reservationMemoryStore struct {
+	db *MemoryDb
+}
+
+func NewReservationMemoryStore(db *MemoryDb) domain.ReservationStore {
+	if db == nil {
+		log.Fatalln("db is nil while initializing reservation memory store")
+	}
+	return &reservationMemoryStore{
+		db: db,
+	}
+}
+
+func (r *reservationMemoryStore) Add(tx domain.Tx, reservation domain.Reservation) error {
+	return r.db.atomic(tx, func(tx *memoryTx) error {
+		if _, found := tx.entities[reservation.GetId()]; found {
+			return fmt.Errorf("reservation %s already exists", reservation.GetId())
+		}
+		if _, found := tx.get(reservation.GetNamespace().GetId(), memoryNamespace); !found {
+			return fmt.Errorf("cannot find namespace %s", reservation.GetNamespace().GetId())
+		}
+		tx.add(&memoryEntity{
+			id:        reservation.GetId(),
+			kind:      memoryReservation,
+			name:      reservation.GetName(),
+			quotas:    make(domain.ResourceQuotas),
+			parentId:  reservation.GetNamespace().GetId(),
+			expiresAt: reservation.GetExpiresAt(),
+		})
+
+		return tx.setResourceQuotas(reservation.GetId(), reservation.GetResourceQuotas())
+	})
+}
+
+func (r *reservationMemoryStore) Get(tx domain.Tx, id string) (domain.Reservation, error) {
+	var reservation domain.Reservation
+	err := r.db.read(tx, func(tx *memoryTx) error {
+		var err error
+		reservation, err = r.get(tx, id)
+		return err
+	})
+	if err != nil {
+		return domain.Reservation{}, err
+	}
+	return reservation, nil
+}
+
+func (r *reservationMemoryStore) FindChildren(tx domain.Tx, namespace domain.Namespace) ([]domain.Reservation, error) {
+	reservations := make([]domain.Reservation, 0)
+	err := r.db.read(tx, func(tx *memoryTx) error {
+		for _, entity := range tx.children(namespace.GetId(), memoryReservation) {
+			reservations = append(reservations, toReservation(namespace, entity))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(reservations, func(a, b domain.Reservation) int {
+		return strings.Compare(a.GetId(), b.GetId())
+	})
+	return reservations, nil
+}
+
+func (r *reservationMemoryStore) ListExpired(tx domain.Tx, now time.Time) ([]domain.Reservation, error) {
+	reservations := make([]domain.Reservation, 0)
+	err := r.db.read(tx, func(tx *memoryTx) error {
+		for id, entity := range tx.entities {
+			if entity.kind != memoryReservation || now.Before(entity.expiresAt) {
+				continue
+			}
+			reservation, err := r.get(tx, id)
+			if err != nil {
+				return err
+			}
+			reservations = append(reservations, reservation)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+func (r *reservationMemoryStore) Remove(tx domain.Tx, id string) error {
+	return r.db.atomic(tx, func(tx *memoryTx) error {
+		if _, found := tx.get(id, memoryReservation); found {
+			tx.remove(id)
+		}
+		return nil
+	})
+}
+
+func (r *reservationMemoryStore) get(tx *memoryTx, id string) (domain.Reservation, error) {
+	entity, found := tx.get(id, memoryReservation)
+	if !found {
+		return domain.Reservation{}, fmt.Errorf("cannot find reservation %s", id)
+	}
+	namespaceEntity, found := tx.get(entity.parentId, memoryNamespace)
+	if !found {
+		return domain.Reservation{}, fmt.Errorf("reservation %s has no namespace", id)
+	}
+	namespace, err := tx.toNamespace(namespaceEntity)
+	if err != nil {
+		return domain.Reservation{}, err
+	}
+	return toReservation(namespace, entity), nil
+}
+
+func toReservation(namespace domain.Namespace, entity *memoryEntity) domain.Reservation {
+	reservation := domain.NewReservation(namespace, entity.name, entity.expiresAt)
+	for resourceName, quota := range entity.quotas {
+		if err := reservation.AddResourceQuota(resourceName, quota); err != nil {
+			log.Println(err)
+		}
+	}
+	return reservation
+}
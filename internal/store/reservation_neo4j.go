@@ -0,0 +1,213 @@
+package store
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/c12s/meridian/internal/domain"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+type reservationNeo4jStore struct {
+	driver neo4j.Driver
+	dbName string
+	quotas domain.ResourceQuotaStore
+}
+
+func NewReservationNeo4jStore(driver neo4j.Driver, dbName string, quotas domain.ResourceQuotaStore) domain.ReservationStore {
+	if driver == nil {
+		log.Fatalln("driver while initializing reservation neo4j store")
+	}
+	return &reservationNeo4jStore{
+		driver: driver,
+		dbName: dbName,
+		quotas: quotas,
+	}
+}
+
+func (r *reservationNeo4jStore) Add(tx domain.Tx, reservation domain.Reservation) error {
+	return atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		_, err := tx.Run(addReservationCypher, map[string]any{
+			"id":           reservation.GetId(),
+			"name":         reservation.GetName(),
+			"expires_at":   reservation.GetExpiresAt().UnixMilli(),
+			"namespace_id": reservation.GetNamespace().GetId(),
+		})
+		if err != nil {
+			return err
+		}
+
+		return r.quotas.SetResourceQuotas(tx, reservation.GetId(), reservation.GetResourceQuotas())
+	})
+}
+
+func (r *reservationNeo4jStore) Get(tx domain.Tx, id string) (domain.Reservation, error) {
+	var reservations []domain.Reservation
+	err := atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(getReservationCypher, map[string]any{
+			"id": id,
+		})
+		if err != nil {
+			return err
+		}
+		reservations, err = r.readReservations(res)
+		return err
+	})
+	if err != nil {
+		return domain.Reservation{}, err
+	}
+	if len(reservations) == 0 {
+		return domain.Reservation{}, fmt.Errorf("cannot find reservation %s", id)
+	}
+	return reservations[0], nil
+}
+
+func (r *reservationNeo4jStore) FindChildren(tx domain.Tx, namespace domain.Namespace) ([]domain.Reservation, error) {
+	var reservations []domain.Reservation
+	err := atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(getChildReservationsCypher, map[string]any{
+			"id": namespace.GetId(),
+		})
+		if err != nil {
+			return err
+		}
+		reservations, err = r.readReservations(res)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+func (r *reservationNeo4jStore) ListExpired(tx domain.Tx, now time.Time) ([]domain.Reservation, error) {
+	var reservations []domain.Reservation
+	err := atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(listExpiredReservationsCypher, map[string]any{
+			"now": now.UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+		reservations, err = r.readReservations(res)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+func (r *reservationNeo4jStore) Remove(tx domain.Tx, id string) error {
+	return atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		_, err := tx.Run(removeReservationCypher, map[string]any{
+			"id": id,
+		})
+		return err
+	})
+}
+
+// readReservations reads reservations of possibly different namespaces, every record holds the reservation and its namespace.
+func (r *reservationNeo4jStore) readReservations(res neo4j.Result) ([]domain.Reservation, error) {
+	reservations := make([]domain.Reservation, 0)
+	if res.Err() != nil {
+		return reservations, res.Err()
+	}
+	records, err := res.Collect()
+	if err != nil {
+		return reservations, err
+	}
+	for _, record := range records {
+		namespaceAny, found := record.Get("namespace")
+		if !found {
+			return reservations, fmt.Errorf("reservation has no namespace")
+		}
+		namespaceProperties, ok := namespaceAny.(map[string]any)
+		if !ok {
+			return reservations, fmt.Errorf("reservation has no namespace")
+		}
+		namespace, err := readNamespace(namespaceProperties, "")
+		if err != nil {
+			return reservations, err
+		}
+		propertiesAny, found := record.Get("properties")
+		if !found {
+			return reservations, fmt.Errorf("reservation has no properties")
+		}
+		properties, ok := propertiesAny.(map[string]any)
+		if !ok {
+			return reservations, fmt.Errorf("reservation has no properties")
+		}
+		reservation, err := readReservation(properties, namespace)
+		if err != nil {
+			return reservations, err
+		}
+		reservations = append(reservations, reservation)
+	}
+	return reservations, nil
+}
+
+func readReservation(properties map[string]any, namespace domain.Namespace) (domain.Reservation, error) {
+	nameAny, found := properties["name"]
+	if !found {
+		return domain.Reservation{}, fmt.Errorf("reservation has no name")
+	}
+	name, ok := nameAny.(string)
+	if !ok {
+		return domain.Reservation{}, fmt.Errorf("reservation name invalid type")
+	}
+	expiresAtAny, found := properties["expires_at"]
+	if !found {
+		return domain.Reservation{}, fmt.Errorf("reservation has no expires_at")
+	}
+	expiresAt, ok := expiresAtAny.(int64)
+	if !ok {
+		return domain.Reservation{}, fmt.Errorf("reservation expires_at invalid type")
+	}
+	reservation := domain.NewReservation(namespace, name, time.UnixMilli(expiresAt))
+	for key, quotaAny := range properties {
+		resourceName, found := strings.CutPrefix(key, quotaPropertyPrefix)
+		if !found {
+			continue
+		}
+		if quota, ok := quotaAny.(int64); !ok {
+			log.Printf("invalid quota type for resource name %s: %v\n", resourceName, quotaAny)
+		} else {
+			if err := reservation.AddResourceQuota(resourceName, quota); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+	return reservation, nil
+}
+
+const addReservationCypher = `
+MATCH (n:Namespace{id: $namespace_id})
+CREATE (r:Reservation:Entity{id: $id, name: $name, expires_at: $expires_at})
+CREATE (n)-[:CHILD]->(r);
+`
+
+const getReservationCypher = `
+MATCH (n:Namespace)-[:CHILD]->(r:Reservation{id: $id})
+RETURN properties(n) AS namespace, properties(r) AS properties;
+`
+
+const getChildReservationsCypher = `
+MATCH (n:Namespace{id: $id})-[:CHILD]->(r:Reservation)
+WITH n, r
+ORDER BY r.id
+RETURN properties(n) AS namespace, properties(r) AS properties;
+`
+
+const listExpiredReservationsCypher = `
+MATCH (n:Namespace)-[:CHILD]->(r:Reservation)
+WHERE r.expires_at <= $now
+RETURN properties(n) AS namespace, properties(r) AS properties;
+`
+
+const removeReservationCypher = `
+MATCH (r:Reservation{id: $id})
+DETACH DELETE r;
+`
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+type resourcePriceMemoryStore struct {
+	db *MemoryDb
+}
+
+func NewResourcePriceMemoryStore(db *MemoryDb) domain.ResourcePriceStore {
+	if db == nil {
+		log.Fatalln("db is nil while initializing resource price memory store")
+	}
+	return &resourcePriceMemoryStore{
+		db: db,
+	}
+}
+
+func (r *resourcePriceMemoryStore) Put(tx domain.Tx, price domain.ResourcePrice) error {
+	return r.db.atomic(tx, func(tx *memoryTx) error {
+		tx.prices[resourceTypeId(price.OrgId, price.Resource)] = price
+		return nil
+	})
+}
+
+func (r *resourcePriceMemoryStore) List(tx domain.Tx, orgId string) ([]domain.ResourcePrice, error) {
+	prices := make([]domain.ResourcePrice, 0)
+	err := r.db.read(tx, func(tx *memoryTx) error {
+		for _, price := range tx.prices {
+			if price.OrgId == orgId {
+				prices = append(prices, price)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(prices, func(a, b domain.ResourcePrice) int {
+		return strings.Compare(a.Resource, b.Resource)
+	})
+	return prices, nil
+}
+
+func (r *resourcePriceMemoryStore) Remove(tx domain.Tx, orgId, resource string) error {
+	return r.db.atomic(tx, func(tx *memoryTx) error {
+		id := resourceTypeId(orgId, resource)
+		if _, found := tx.prices[id]; !found {
+			return fmt.Errorf("cannot find price of resource %s", resource)
+		}
+		delete(tx.prices, id)
+		return nil
+	})
+}
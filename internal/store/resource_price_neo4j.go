@@ -0,0 +1,121 @@
+package store
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/c12s/meridian/internal/domain"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+type resourcePriceNeo4jStore struct {
+	driver neo4j.Driver
+	dbName string
+}
+
+func NewResourcePriceNeo4jStore(driver neo4j.Driver, dbName string) domain.ResourcePriceStore {
+	if driver == nil {
+		log.Fatalln("driver is nil while initializing resource price neo4j store")
+	}
+	return &resourcePriceNeo4jStore{
+		driver: driver,
+		dbName: dbName,
+	}
+}
+
+func (r *resourcePriceNeo4jStore) Put(tx domain.Tx, price domain.ResourcePrice) error {
+	return atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		_, err := tx.Run(putResourcePriceCypher, map[string]any{
+			"org_id":   price.OrgId,
+			"resource": price.Resource,
+			"per":      price.Per,
+			"per_hour": price.PerHour,
+		})
+		return err
+	})
+}
+
+func (r *resourcePriceNeo4jStore) List(tx domain.Tx, orgId string) ([]domain.ResourcePrice, error) {
+	prices := make([]domain.ResourcePrice, 0)
+	err := atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(listResourcePricesCypher, map[string]any{
+			"org_id": orgId,
+		})
+		if err != nil {
+			return err
+		}
+		records, err := res.Collect()
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			propertiesAny, found := record.Get("properties")
+			if !found {
+				return fmt.Errorf("resource price has no properties")
+			}
+			properties, ok := propertiesAny.(map[string]any)
+			if !ok {
+				return fmt.Errorf("resource price has no properties")
+			}
+			resource, ok := properties["resource"].(string)
+			if !ok {
+				return fmt.Errorf("resource price resource invalid type")
+			}
+			per, ok := properties["per"].(int64)
+			if !ok {
+				return fmt.Errorf("price of resource %s per invalid type", resource)
+			}
+			perHour, ok := properties["per_hour"].(string)
+			if !ok {
+				return fmt.Errorf("price of resource %s per_hour invalid type", resource)
+			}
+			prices = append(prices, domain.ResourcePrice{
+				OrgId:    orgId,
+				Resource: resource,
+				Per:      per,
+				PerHour:  perHour,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
+
+func (r *resourcePriceNeo4jStore) Remove(tx domain.Tx, orgId, resource string) error {
+	return atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(removeResourcePriceCypher, map[string]any{
+			"org_id":   orgId,
+			"resource": resource,
+		})
+		if err != nil {
+			return err
+		}
+		summary, err := res.Consume()
+		if err != nil {
+			return err
+		}
+		if summary.Counters().NodesDeleted() == 0 {
+			return fmt.Errorf("cannot find price of resource %s", resource)
+		}
+		return nil
+	})
+}
+
+const putResourcePriceCypher = `
+MERGE (p:ResourcePrice{org_id: $org_id, resource: $resource})
+SET p.per = $per, p.per_hour = $per_hour;
+`
+
+const listResourcePricesCypher = `
+MATCH (p:ResourcePrice{org_id: $org_id})
+RETURN properties(p) AS properties
+ORDER BY p.resource;
+`
+
+const removeResourcePriceCypher = `
+MATCH (p:ResourcePrice{org_id: $org_id, resource: $resource})
+DELETE p;
+`
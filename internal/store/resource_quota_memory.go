@@ -0,0 +1,64 @@
+package store
+
+import (
+	"log"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+type resourceQuotaMemoryStore struct {
+	db *MemoryDb
+}
+
+func NewResourceQuotaMemoryStore(db *MemoryDb) domain.ResourceQuotaStore {
+	if db == nil {
+		log.Fatalln("db is nil while initializing resource quota memory store")
+	}
+	return &resourceQuotaMemoryStore{
+		db: db,
+	}
+}
+
+func (r *resourceQuotaMemoryStore) SetResourceQuotas(tx domain.Tx, entityId string, quotas domain.ResourceQuotas) error {
+	return r.db.atomic(tx, func(tx *memoryTx) error {
+		return tx.setResourceQuotas(entityId, quotas)
+	})
+}
+
+func (r *resourceQuotaMemoryStore) GetAvailableResources(tx domain.Tx, entityId string) (domain.ResourceQuotas, error) {
+	var available domain.ResourceQuotas
+	err := r.db.read(tx, func(tx *memoryTx) error {
+		var err error
+		available, err = tx.getAvailableResources(entityId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return available, nil
+}
+
+func (r *resourceQuotaMemoryStore) SetOvercommits(tx domain.Tx, entityId string, overcommits domain.Overcommits) error {
+	return r.db.atomic(tx, func(tx *memoryTx) error {
+		return tx.setOvercommits(entityId, overcommits)
+	})
+}
+
+func (r *resourceQuotaMemoryStore) GetUtilizedResources(tx domain.Tx, entityId string) (domain.ResourceQuotas, error) {
+	var utilized domain.ResourceQuotas
+	err := r.db.read(tx, func(tx *memoryTx) error {
+		var err error
+		utilized, err = tx.getUtilizedResources(entityId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utilized, nil
+}
+
+func (r *resourceQuotaMemoryStore) SetElasticMax(tx domain.Tx, entityId string, elasticMax domain.ResourceQuotas) error {
+	return r.db.atomic(tx, func(tx *memoryTx) error {
+		return tx.setElasticMax(entityId, elasticMax)
+	})
+}
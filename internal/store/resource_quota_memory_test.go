@@ -0,0 +1,63 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+func TestResourceQuotaMemoryStoreSetResourceQuotas(t *testing.T) {
+	namespace := func(name string, cpu int64) domain.Namespace {
+		namespace := domain.NewNamespace("org", name, "", nil)
+		if err := namespace.AddResourceQuota("cpu", cpu); err != nil {
+			t.Fatalf("AddResourceQuota() error = %v", err)
+		}
+		return namespace
+	}
+	tests := []struct {
+		name    string
+		id      string
+		cpu     int64
+		wantErr bool
+	}{
+		{name: "child within the parent available", id: "b", cpu: 7},
+		{name: "child above the parent available", id: "b", cpu: 8, wantErr: true},
+		{name: "parent above its utilization", id: "a", cpu: 7},
+		{name: "parent below its utilization", id: "a", cpu: 6, wantErr: true},
+		{name: "lowered child quota", id: "c", cpu: 1},
+		{name: "top-level namespace", id: "a", cpu: 1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := NewMemoryDb()
+			namespaces := NewNamespaceMemoryStore(db)
+			quotas := NewResourceQuotaMemoryStore(db)
+			a, b, c := namespace("a", 10), namespace("b", 4), namespace("c", 3)
+			for _, add := range []struct {
+				namespace domain.Namespace
+				parent    *domain.Namespace
+			}{{a, nil}, {b, &a}, {c, &a}} {
+				if err := namespaces.Add(nil, add.namespace, add.parent); err != nil {
+					t.Fatalf("Add(%s) error = %v", add.namespace.GetId(), err)
+				}
+			}
+			id := domain.MakeNamespaceId("org", tt.id)
+			err := quotas.SetResourceQuotas(nil, id, domain.ResourceQuotas{"cpu": tt.cpu})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetResourceQuotas() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			namespace, err := namespaces.Get(nil, id)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			want := tt.cpu
+			if tt.wantErr {
+				// rejected quotas leave the stored ones unchanged
+				want = map[string]int64{"a": 10, "b": 4, "c": 3}[tt.id]
+			}
+			if got := namespace.GetResourceQuotas()["cpu"]; got != want {
+				t.Errorf("cpu quota = %v, want %v", got, want)
+			}
+		})
+	}
+}
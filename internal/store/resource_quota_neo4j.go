@@ -3,6 +3,7 @@ package store
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/c12s/meridian/internal/domain"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
@@ -23,68 +24,78 @@ func NewResourceQuotaNeo4jStore(driver neo4j.Driver, dbName string) domain.Resou
 	}
 }
 
-func (n *resourceQuotaNeo4jStore) SetResourceQuotas(entityId string, quotas domain.ResourceQuotas, tx neo4j.Transaction) error {
-	if tx == nil {
-		session := startSession(n.driver, n.dbName)
-		defer endSession(session)
-		newTx, err := session.BeginTransaction()
+func (n *resourceQuotaNeo4jStore) SetResourceQuotas(tx domain.Tx, entityId string, quotas domain.ResourceQuotas) error {
+	return atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		total, err := n.getQuotas(tx, entityId)
 		if err != nil {
 			return err
 		}
-		tx = newTx
-	}
 
-	total, err := n.getQuotas(tx, entityId)
-	if err != nil {
-		tx.Rollback()
-		return err
-	}
+		parentEntityId, err := n.getParentEntity(tx, entityId)
+		if err != nil {
+			log.Println(err)
+		} else {
+			availableParent, err := n.getAvailableResources(tx, parentEntityId)
+			if err != nil {
+				return err
+			}
+			for resource, quota := range quotas {
+				if available := availableParent[resource] + total[resource]; available < quota {
+					return fmt.Errorf("requested %s quota for the resource %s, but only %s available in parent", domain.FormatMilliUnits(quota), resource, domain.FormatMilliUnits(available))
+				}
+			}
+		}
 
-	parentEntityId, err := n.getParentEntity(tx, entityId)
-	if err != nil {
-		log.Println(err)
-	} else {
-		availableParent, err := n.GetAvailableResources(tx, parentEntityId)
+		overcommits, err := n.getOvercommits(tx, entityId)
+		if err != nil {
+			return err
+		}
+		usage, err := n.getResourceUsage(tx, entityId)
 		if err != nil {
-			tx.Rollback()
 			return err
 		}
 		for resource, quota := range quotas {
-			if available := availableParent[resource] + total[resource]; available < quota {
-				return fmt.Errorf("requested %f quota for the resource %s, but only %f available in parent", quota, resource, available)
+			resourceUsage := usage[resource]
+			resourceUsage.Total = domain.EffectiveQuota(quota, overcommits.Get(resource))
+			if !resourceUsage.Fits() {
+				return fmt.Errorf("requested %s quota for the resource %s, but %s already utilized", domain.FormatMilliUnits(quota), resource, domain.FormatMilliUnits(resourceUsage.Utilized))
 			}
 		}
-	}
 
-	available, err := n.GetAvailableResources(tx, entityId)
-	if err != nil {
-		tx.Rollback()
-		return err
-	}
-	for resource, quota := range quotas {
-		utilized := total[resource] - available[resource]
-		if utilized > quota {
-			return fmt.Errorf("requested %f quota for the resource %s, but %f already utilized", quota, resource, utilized)
-		}
-	}
+		return n.setResourceQuotas(tx, entityId, quotas)
+	})
+}
 
-	err = n.setResourceQuotas(tx, entityId, quotas)
+func (n *resourceQuotaNeo4jStore) getQuotas(tx neo4j.Transaction, id string) (domain.ResourceQuotas, error) {
+	res, err := tx.Run(getEntityCypher, map[string]any{
+		"id": id,
+	})
 	if err != nil {
-		tx.Rollback()
-		return err
+		return nil, err
 	}
-	tx.Commit()
-	return nil
+	return n.readQuotas(res)
 }
 
-func (n *resourceQuotaNeo4jStore) getQuotas(tx neo4j.Transaction, id string) (domain.ResourceQuotas, error) {
+func (n *resourceQuotaNeo4jStore) getOvercommits(tx neo4j.Transaction, id string) (domain.Overcommits, error) {
 	res, err := tx.Run(getEntityCypher, map[string]any{
 		"id": id,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return n.readQuotas(res)
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	record, err := res.Single()
+	if err != nil {
+		return nil, err
+	}
+	propertiesAny, _ := record.Get("properties")
+	properties, ok := propertiesAny.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("entity has no properties")
+	}
+	return readOvercommits(properties), nil
 }
 
 func (n *resourceQuotaNeo4jStore) getParentEntity(tx neo4j.Transaction, entityId string) (string, error) {
@@ -97,22 +108,63 @@ func (n *resourceQuotaNeo4jStore) getParentEntity(tx neo4j.Transaction, entityId
 	return n.readEntityId(res)
 }
 
-func (n *resourceQuotaNeo4jStore) GetAvailableResources(tx neo4j.Transaction, entityId string) (domain.ResourceQuotas, error) {
-	if tx == nil {
-		session := startSession(n.driver, n.dbName)
-		defer endSession(session)
-		newTx, err := session.BeginTransaction()
+func (n *resourceQuotaNeo4jStore) GetAvailableResources(tx domain.Tx, entityId string) (domain.ResourceQuotas, error) {
+	var available domain.ResourceQuotas
+	err := atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		var err error
+		available, err = n.getAvailableResources(tx, entityId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return available, nil
+}
+
+func (n *resourceQuotaNeo4jStore) getAvailableResources(tx neo4j.Transaction, entityId string) (domain.ResourceQuotas, error) {
+	usage, err := n.getResourceUsage(tx, entityId)
+	if err != nil {
+		return nil, err
+	}
+	available := make(domain.ResourceQuotas)
+	for resourceName, resourceUsage := range usage {
+		available[resourceName] = resourceUsage.Available()
+	}
+	return available, nil
+}
+
+func (n *resourceQuotaNeo4jStore) GetUtilizedResources(tx domain.Tx, entityId string) (domain.ResourceQuotas, error) {
+	utilized := make(domain.ResourceQuotas)
+	err := atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		usage, err := n.getResourceUsage(tx, entityId)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		tx = newTx
+		for resourceName, resourceUsage := range usage {
+			utilized[resourceName] = resourceUsage.Utilized
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return utilized, nil
+}
 
-	quotas := make(map[string]float64)
-	for _, resourceName := range domain.SupportedResourceQuotas {
-		res, err := tx.Run(getAvailableResourcesCypher, map[string]any{
-			"id":            entityId,
-			"resource_name": resourceName,
+// getResourceUsage returns the usage of the resources the entity has quotas for.
+func (n *resourceQuotaNeo4jStore) getResourceUsage(tx neo4j.Transaction, entityId string) (map[string]domain.ResourceUsage, error) {
+	total, err := n.getQuotas(tx, entityId)
+	if err != nil {
+		return nil, err
+	}
+	usage := make(map[string]domain.ResourceUsage)
+	for resourceName := range total {
+		res, err := tx.Run(getResourceUsageCypher, map[string]any{
+			"id":               entityId,
+			"property":         quotaPropertyPrefix + resourceName,
+			"overcommit_name":  overcommitPropertyPrefix + resourceName,
+			"elastic_max_name": elasticMaxPropertyPrefix + resourceName,
+			"no_overcommit":    domain.NoOvercommit,
 		})
 		if err != nil {
 			return nil, err
@@ -124,31 +176,125 @@ func (n *resourceQuotaNeo4jStore) GetAvailableResources(tx neo4j.Transaction, en
 		if err != nil {
 			return nil, err
 		}
-		if len(records) == 0 || len(records[0].Values) == 0 {
+		if len(records) == 0 || len(records[0].Values) < 4 {
 			return nil, fmt.Errorf("available resources not found for resource %s", resourceName)
 		}
-		availableAny := records[0].Values[0]
-		available, ok := availableAny.(float64)
-		if !ok {
-			log.Printf("available resources for %s cannot be converted to float: %v", resourceName, availableAny)
-		} else {
-			quotas[resourceName] = available
+		values := make([]int64, 0, 4)
+		for _, valueAny := range records[0].Values {
+			value, ok := valueAny.(int64)
+			if !ok {
+				break
+			}
+			values = append(values, value)
+		}
+		if len(values) < 4 {
+			log.Printf("available resources for %s cannot be converted to integer: %v", resourceName, records[0].Values)
+			continue
+		}
+		usage[resourceName] = domain.ResourceUsage{
+			Total:        values[0],
+			Utilized:     values[1],
+			ElasticMax:   values[2],
+			SiblingSpare: values[3],
 		}
 	}
-	return quotas, nil
+	return usage, nil
 }
 
 func (n *resourceQuotaNeo4jStore) setResourceQuotas(tx neo4j.Transaction, entityId string, quotas domain.ResourceQuotas) error {
-	for resource, quota := range quotas {
-		_, err := tx.Run(setQuotaCypher(resource), map[string]any{
-			"id":    entityId,
-			"quota": quota,
+	_, err := tx.Run(setQuotasCypher, map[string]any{
+		"id":     entityId,
+		"quotas": quotaProperties(quotas),
+	})
+	return err
+}
+
+func (n *resourceQuotaNeo4jStore) SetOvercommits(tx domain.Tx, entityId string, overcommits domain.Overcommits) error {
+	return atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		total, err := n.getQuotas(tx, entityId)
+		if err != nil {
+			return err
+		}
+		usage, err := n.getResourceUsage(tx, entityId)
+		if err != nil {
+			return err
+		}
+		properties := make(map[string]any)
+		for resource, overcommit := range overcommits {
+			resourceUsage := usage[resource]
+			resourceUsage.Total = domain.EffectiveQuota(total[resource], overcommit)
+			if !resourceUsage.Fits() {
+				return fmt.Errorf("overcommit of the resource %s leaves %s effective quota, but %s already utilized", resource, domain.FormatMilliUnits(resourceUsage.Total), domain.FormatMilliUnits(resourceUsage.Utilized))
+			}
+			properties[overcommitPropertyPrefix+resource] = overcommit
+		}
+		_, err = tx.Run(setEntityPropertiesCypher, map[string]any{
+			"id":         entityId,
+			"properties": properties,
 		})
+		return err
+	})
+}
+
+func (n *resourceQuotaNeo4jStore) SetElasticMax(tx domain.Tx, entityId string, elasticMax domain.ResourceQuotas) error {
+	return atomic(n.driver, n.dbName, tx, func(tx neo4j.Transaction) error {
+		usage, err := n.getResourceUsage(tx, entityId)
 		if err != nil {
 			return err
 		}
+		properties := make(map[string]any)
+		for resource, elastic := range elasticMax {
+			resourceUsage := usage[resource]
+			resourceUsage.ElasticMax = elastic
+			if !resourceUsage.Fits() {
+				return fmt.Errorf("requested %s elastic max for the resource %s, but %s already utilized", domain.FormatMilliUnits(elastic), resource, domain.FormatMilliUnits(resourceUsage.Utilized))
+			}
+			properties[elasticMaxPropertyPrefix+resource] = elastic
+		}
+		_, err = tx.Run(setEntityPropertiesCypher, map[string]any{
+			"id":         entityId,
+			"properties": properties,
+		})
+		return err
+	})
+}
+
+// overcommit factors and elastic max quotas are stored as separate node properties next to the quotas
+const (
+	overcommitPropertyPrefix = "overcommit."
+	elasticMaxPropertyPrefix = "elastic_max."
+)
+
+func readOvercommits(properties map[string]any) domain.Overcommits {
+	overcommits := make(domain.Overcommits)
+	for key, value := range properties {
+		resource, found := strings.CutPrefix(key, overcommitPropertyPrefix)
+		if !found {
+			continue
+		}
+		if overcommit, ok := value.(int64); !ok {
+			log.Printf("invalid overcommit type for resource name %s: %v\n", resource, value)
+		} else {
+			overcommits[resource] = overcommit
+		}
+	}
+	return overcommits
+}
+
+func readElasticMax(properties map[string]any) domain.ResourceQuotas {
+	elasticMax := make(domain.ResourceQuotas)
+	for key, value := range properties {
+		resource, found := strings.CutPrefix(key, elasticMaxPropertyPrefix)
+		if !found {
+			continue
+		}
+		if quota, ok := value.(int64); !ok {
+			log.Printf("invalid elastic max type for resource name %s: %v\n", resource, value)
+		} else {
+			elasticMax[resource] = quota
+		}
 	}
-	return nil
+	return elasticMax
 }
 
 func (n *resourceQuotaNeo4jStore) readEntityId(res neo4j.Result) (string, error) {
@@ -203,14 +349,15 @@ func (n *resourceQuotaNeo4jStore) readQuotas(res neo4j.Result) (domain.ResourceQ
 		return nil, fmt.Errorf("entity has no properties")
 	}
 	quotas := make(domain.ResourceQuotas)
-	for _, resourceName := range domain.SupportedResourceQuotas {
-		quotaAny, found := properties[resourceName]
-		if found {
-			if quota, ok := quotaAny.(float64); !ok {
-				log.Printf("invalid quota type for resource name %s: %v\n", resourceName, quotaAny)
-			} else {
-				quotas[resourceName] = quota
-			}
+	for key, quotaAny := range properties {
+		resourceName, found := strings.CutPrefix(key, quotaPropertyPrefix)
+		if !found {
+			continue
+		}
+		if quota, ok := quotaAny.(int64); !ok {
+			log.Printf("invalid quota type for resource name %s: %v\n", resourceName, quotaAny)
+		} else {
+			quotas[resourceName] = quota
 		}
 	}
 	return quotas, nil
@@ -227,15 +374,38 @@ MATCH (e:Entity{id: $id})
 RETURN properties(e) AS properties;
 `
 
-const getAvailableResourcesCypher = `
+// getResourceUsageCypher returns the quota of the entity, the sum of the quotas of its children
+// and what its sibling namespaces have left after handing out quotas to their own children
+const getResourceUsageCypher = `
 MATCH (n:Entity{id: $id})
 OPTIONAL MATCH (d:Entity)<-[:CHILD]-(n)
-WITH n, collect(d[$resource_name]) as utilized_list
-WITH reduce(total_utilized = 0, utilized in utilized_list | total_utilized + utilized) AS total_utilized,
-	 n[$resource_name] AS total
-RETURN total - total_utilized AS available;
+WITH n, reduce(total_utilized = 0, utilized in collect(d[$property]) | total_utilized + utilized) AS utilized
+OPTIONAL MATCH (p:Namespace)-[:CHILD]->(n)
+OPTIONAL MATCH (p)-[:CHILD]->(s:Namespace)
+WHERE s <> n
+OPTIONAL MATCH (s)-[:CHILD]->(sd:Entity)
+WITH n, utilized, s, reduce(total_utilized = 0, utilized in collect(sd[$property]) | total_utilized + utilized) AS sibling_utilized
+WITH n, utilized,
+	 collect(coalesce(s[$property] * coalesce(s[$overcommit_name], $no_overcommit) / $no_overcommit, 0) - sibling_utilized) AS sibling_spares
+RETURN n[$property] * coalesce(n[$overcommit_name], $no_overcommit) / $no_overcommit AS total,
+	   utilized,
+	   coalesce(n[$elastic_max_name], 0) AS elastic_max,
+	   reduce(total_spare = 0, spare in sibling_spares | total_spare + spare) AS sibling_spare;
+`
+
+const setQuotasCypher = `
+MATCH (e:Entity{id: $id})
+SET e += $quotas;
 `
 
-func setQuotaCypher(resource string) string {
-	return fmt.Sprintf("MATCH (e:Entity{id: $id})SET e.%s = $quota;", resource)
+// quotas are stored as separate node properties under a prefix,
+// so that resource types cannot clash with the other entity properties
+const quotaPropertyPrefix = "quotas."
+
+func quotaProperties(quotas domain.ResourceQuotas) map[string]any {
+	properties := make(map[string]any)
+	for resource, quota := range quotas {
+		properties[quotaPropertyPrefix+resource] = quota
+	}
+	return properties
 }
@@ -0,0 +1,96 @@
+package store
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+type resourceTypeMemoryStore struct {
+	db *MemoryDb
+}
+
+func NewResourceTypeMemoryStore(db *MemoryDb) domain.ResourceTypeStore {
+	if db == nil {
+		log.Fatalln("db is nil while initializing resource type memory store")
+	}
+	return &resourceTypeMemoryStore{
+		db: db,
+	}
+}
+
+func (r *resourceTypeMemoryStore) Put(tx domain.Tx, resourceType domain.ResourceType) error {
+	return r.db.atomic(tx, func(tx *memoryTx) error {
+		tx.resourceTypes[resourceTypeId(resourceType.OrgId, resourceType.Name)] = resourceType
+		tx.resourceTypesVersion++
+		return nil
+	})
+}
+
+func (r *resourceTypeMemoryStore) List(tx domain.Tx) ([]domain.ResourceType, error) {
+	types := make([]domain.ResourceType, 0)
+	err := r.db.read(tx, func(tx *memoryTx) error {
+		for _, resourceType := range tx.resourceTypes {
+			types = append(types, resourceType)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+func (r *resourceTypeMemoryStore) Version(tx domain.Tx) (int64, error) {
+	var version int64
+	err := r.db.read(tx, func(tx *memoryTx) error {
+		version = tx.resourceTypesVersion
+		return nil
+	})
+	return version, err
+}
+
+func (r *resourceTypeMemoryStore) InUse(tx domain.Tx, orgId, name string) (bool, error) {
+	inUse := false
+	err := r.db.read(tx, func(tx *memoryTx) error {
+		inUse = tx.resourceTypeInUse(orgId, name)
+		return nil
+	})
+	return inUse, err
+}
+
+func (r *resourceTypeMemoryStore) Remove(tx domain.Tx, orgId, name string) error {
+	return r.db.atomic(tx, func(tx *memoryTx) error {
+		id := resourceTypeId(orgId, name)
+		if _, found := tx.resourceTypes[id]; !found {
+			return fmt.Errorf("cannot find resource type %s", name)
+		}
+		delete(tx.resourceTypes, id)
+		tx.resourceTypesVersion++
+		return nil
+	})
+}
+
+func (tx *memoryTx) resourceTypeInUse(orgId, name string) bool {
+	for entityId, entity := range tx.entities {
+		if _, found := entity.quotas[name]; found && strings.HasPrefix(entityId, orgPrefix(orgId)) {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceTypeId(orgId, name string) string {
+	return fmt.Sprintf("%s/%s", orgId, name)
+}
+
+// orgPrefix is the prefix of the ids of all entities of the org,
+// it matches all entities if the org id is empty.
+func orgPrefix(orgId string) string {
+	if orgId == "" {
+		return ""
+	}
+	return domain.MakeNamespaceId(orgId, "")
+}
@@ -0,0 +1,203 @@
+package store
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/c12s/meridian/internal/domain"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+type resourceTypeNeo4jStore struct {
+	driver neo4j.Driver
+	dbName string
+}
+
+func NewResourceTypeNeo4jStore(driver neo4j.Driver, dbName string) domain.ResourceTypeStore {
+	if driver == nil {
+		log.Fatalln("driver is nil while initializing resource type neo4j store")
+	}
+	return &resourceTypeNeo4jStore{
+		driver: driver,
+		dbName: dbName,
+	}
+}
+
+func (r *resourceTypeNeo4jStore) Put(tx domain.Tx, resourceType domain.ResourceType) error {
+	return atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		_, err := tx.Run(putResourceTypeCypher, map[string]any{
+			"org_id":      resourceType.OrgId,
+			"name":        resourceType.Name,
+			"unit":        resourceType.Unit,
+			"description": resourceType.Description,
+			"divisible":   resourceType.Divisible,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = tx.Run(incrementResourceTypeVersionCypher, nil)
+		return err
+	})
+}
+
+func (r *resourceTypeNeo4jStore) List(tx domain.Tx) ([]domain.ResourceType, error) {
+	var types []domain.ResourceType
+	err := atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(listResourceTypesCypher, nil)
+		if err != nil {
+			return err
+		}
+		types, err = r.readResourceTypes(res)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+func (r *resourceTypeNeo4jStore) Version(tx domain.Tx) (int64, error) {
+	var version int64
+	err := atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(getResourceTypeVersionCypher, nil)
+		if err != nil {
+			return err
+		}
+		record, err := res.Single()
+		if err != nil {
+			return err
+		}
+		var ok bool
+		version, ok = record.Values[0].(int64)
+		if !ok {
+			return fmt.Errorf("resource type version invalid type: %v", record.Values[0])
+		}
+		return nil
+	})
+	return version, err
+}
+
+func (r *resourceTypeNeo4jStore) InUse(tx domain.Tx, orgId, name string) (bool, error) {
+	inUse := false
+	err := atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		var err error
+		inUse, err = r.inUse(tx, orgId, name)
+		return err
+	})
+	return inUse, err
+}
+
+func (r *resourceTypeNeo4jStore) Remove(tx domain.Tx, orgId, name string) error {
+	return atomic(r.driver, r.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(removeResourceTypeCypher, map[string]any{
+			"org_id": orgId,
+			"name":   name,
+		})
+		if err != nil {
+			return err
+		}
+		summary, err := res.Consume()
+		if err != nil {
+			return err
+		}
+		if summary.Counters().NodesDeleted() == 0 {
+			return fmt.Errorf("cannot find resource type %s", name)
+		}
+		_, err = tx.Run(incrementResourceTypeVersionCypher, nil)
+		return err
+	})
+}
+
+func (r *resourceTypeNeo4jStore) inUse(tx neo4j.Transaction, orgId, name string) (bool, error) {
+	res, err := tx.Run(countResourceQuotasCypher, map[string]any{
+		"prefix":   orgPrefix(orgId),
+		"property": quotaPropertyPrefix + name,
+	})
+	if err != nil {
+		return false, err
+	}
+	record, err := res.Single()
+	if err != nil {
+		return false, err
+	}
+	count, ok := record.Values[0].(int64)
+	if !ok {
+		return false, fmt.Errorf("resource type %s quota count invalid type", name)
+	}
+	return count > 0, nil
+}
+
+func (r *resourceTypeNeo4jStore) readResourceTypes(res neo4j.Result) ([]domain.ResourceType, error) {
+	types := make([]domain.ResourceType, 0)
+	if res.Err() != nil {
+		return types, res.Err()
+	}
+	records, err := res.Collect()
+	if err != nil {
+		return types, err
+	}
+	for _, record := range records {
+		propertiesAny, found := record.Get("properties")
+		if !found {
+			return types, fmt.Errorf("resource type has no properties")
+		}
+		properties, ok := propertiesAny.(map[string]any)
+		if !ok {
+			return types, fmt.Errorf("resource type has no properties")
+		}
+		resourceType := domain.ResourceType{}
+		for key, value := range map[string]*string{
+			"org_id":      &resourceType.OrgId,
+			"name":        &resourceType.Name,
+			"unit":        &resourceType.Unit,
+			"description": &resourceType.Description,
+		} {
+			valueAny, found := properties[key]
+			if !found {
+				return types, fmt.Errorf("resource type has no %s", key)
+			}
+			*value, ok = valueAny.(string)
+			if !ok {
+				return types, fmt.Errorf("resource type %s invalid type", key)
+			}
+		}
+		resourceType.Divisible, ok = properties["divisible"].(bool)
+		if !ok {
+			return types, fmt.Errorf("resource type divisible invalid type")
+		}
+		types = append(types, resourceType)
+	}
+	return types, nil
+}
+
+const putResourceTypeCypher = `
+MERGE (t:ResourceType{org_id: $org_id, name: $name})
+SET t.unit = $unit, t.description = $description, t.divisible = $divisible;
+`
+
+const listResourceTypesCypher = `
+MATCH (t:ResourceType)
+RETURN properties(t) AS properties;
+`
+
+const countResourceQuotasCypher = `
+MATCH (e:Entity)
+WHERE e.id STARTS WITH $prefix AND e[$property] IS NOT NULL
+RETURN count(e) AS count;
+`
+
+const removeResourceTypeCypher = `
+MATCH (t:ResourceType{org_id: $org_id, name: $name})
+DELETE t;
+`
+
+const incrementResourceTypeVersionCypher = `
+MERGE (v:ResourceTypeVersion)
+ON CREATE SET v.version = 0
+SET v.version = v.version + 1;
+`
+
+const getResourceTypeVersionCypher = `
+OPTIONAL MATCH (v:ResourceTypeVersion)
+RETURN coalesce(v.version, 0) AS version;
+`
@@ -0,0 +1,114 @@
+package store
+
+import (
+	"cmp"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+type scheduledQuotaChangeMemoryStore struct {
+	db *MemoryDb
+}
+
+func NewScheduledQuotaChangeMemoryStore(db *MemoryDb) domain.ScheduledQuotaChangeStore {
+	if db == nil {
+		log.Fatalln("db is nil while initializing scheduled quota change memory store")
+	}
+	return &scheduledQuotaChangeMemoryStore{
+		db: db,
+	}
+}
+
+func (s *scheduledQuotaChangeMemoryStore) Add(tx domain.Tx, change domain.ScheduledQuotaChange) error {
+	return s.db.atomic(tx, func(tx *memoryTx) error {
+		if _, found := tx.schedules[change.GetId()]; found {
+			return fmt.Errorf("scheduled quota change %s already exists", change.GetId())
+		}
+		tx.schedules[change.GetId()] = change
+		return nil
+	})
+}
+
+func (s *scheduledQuotaChangeMemoryStore) Get(tx domain.Tx, id string) (domain.ScheduledQuotaChange, error) {
+	var change domain.ScheduledQuotaChange
+	err := s.db.read(tx, func(tx *memoryTx) error {
+		var found bool
+		change, found = tx.schedules[id]
+		if !found {
+			return fmt.Errorf("cannot find scheduled quota change %s", id)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.ScheduledQuotaChange{}, err
+	}
+	return change, nil
+}
+
+func (s *scheduledQuotaChangeMemoryStore) List(tx domain.Tx, orgId string) ([]domain.ScheduledQuotaChange, error) {
+	changes := make([]domain.ScheduledQuotaChange, 0)
+	err := s.db.read(tx, func(tx *memoryTx) error {
+		for _, change := range tx.schedules {
+			if change.GetOrgId() == orgId {
+				changes = append(changes, change)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// finished changes have no next run
+	finished := func(change domain.ScheduledQuotaChange) int {
+		if change.GetNextRunAt().IsZero() {
+			return 1
+		}
+		return 0
+	}
+	slices.SortFunc(changes, func(a, b domain.ScheduledQuotaChange) int {
+		return cmp.Or(
+			cmp.Compare(finished(a), finished(b)),
+			a.GetNextRunAt().Compare(b.GetNextRunAt()),
+			strings.Compare(a.GetId(), b.GetId()),
+		)
+	})
+	return changes, nil
+}
+
+func (s *scheduledQuotaChangeMemoryStore) ListDue(tx domain.Tx, now time.Time) ([]domain.ScheduledQuotaChange, error) {
+	changes := make([]domain.ScheduledQuotaChange, 0)
+	err := s.db.read(tx, func(tx *memoryTx) error {
+		for _, change := range tx.schedules {
+			if change.GetStatus() == domain.ScheduledQuotaChangeScheduled && !change.GetNextRunAt().After(now) {
+				changes = append(changes, change)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func (s *scheduledQuotaChangeMemoryStore) SetRun(tx domain.Tx, change domain.ScheduledQuotaChange) error {
+	return s.db.atomic(tx, func(tx *memoryTx) error {
+		if _, found := tx.schedules[change.GetId()]; !found {
+			return fmt.Errorf("cannot find scheduled quota change %s", change.GetId())
+		}
+		tx.schedules[change.GetId()] = change
+		return nil
+	})
+}
+
+func (s *scheduledQuotaChangeMemoryStore) Remove(tx domain.Tx, id string) error {
+	return s.db.atomic(tx, func(tx *memoryTx) error {
+		delete(tx.schedules, id)
+		return nil
+	})
+}
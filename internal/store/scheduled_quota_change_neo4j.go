@@ -0,0 +1,252 @@
+package store
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/c12s/meridian/internal/domain"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+)
+
+type scheduledQuotaChangeNeo4jStore struct {
+	driver neo4j.Driver
+	dbName string
+}
+
+func NewScheduledQuotaChangeNeo4jStore(driver neo4j.Driver, dbName string) domain.ScheduledQuotaChangeStore {
+	if driver == nil {
+		log.Fatalln("driver is nil while initializing scheduled quota change neo4j store")
+	}
+	return &scheduledQuotaChangeNeo4jStore{
+		driver: driver,
+		dbName: dbName,
+	}
+}
+
+func (s *scheduledQuotaChangeNeo4jStore) Add(tx domain.Tx, change domain.ScheduledQuotaChange) error {
+	return atomic(s.driver, s.dbName, tx, func(tx neo4j.Transaction) error {
+		properties := map[string]any{
+			"org_id":         change.GetOrgId(),
+			"namespace_name": change.GetNamespaceName(),
+			"app_name":       change.GetAppName(),
+			"cron":           change.GetCron(),
+		}
+		maps.Copy(properties, runProperties(change))
+		// scheduled changes are not entities, so their target quotas do not count as utilized
+		maps.Copy(properties, quotaProperties(change.GetResourceQuotas()))
+		_, err := tx.Run(addScheduledQuotaChangeCypher, map[string]any{
+			"id":         change.GetId(),
+			"properties": properties,
+		})
+		return err
+	})
+}
+
+func (s *scheduledQuotaChangeNeo4jStore) Get(tx domain.Tx, id string) (domain.ScheduledQuotaChange, error) {
+	var changes []domain.ScheduledQuotaChange
+	err := atomic(s.driver, s.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(getScheduledQuotaChangeCypher, map[string]any{
+			"id": id,
+		})
+		if err != nil {
+			return err
+		}
+		changes, err = s.readScheduledQuotaChanges(res)
+		return err
+	})
+	if err != nil {
+		return domain.ScheduledQuotaChange{}, err
+	}
+	if len(changes) == 0 {
+		return domain.ScheduledQuotaChange{}, fmt.Errorf("cannot find scheduled quota change %s", id)
+	}
+	return changes[0], nil
+}
+
+func (s *scheduledQuotaChangeNeo4jStore) List(tx domain.Tx, orgId string) ([]domain.ScheduledQuotaChange, error) {
+	var changes []domain.ScheduledQuotaChange
+	err := atomic(s.driver, s.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(listScheduledQuotaChangesCypher, map[string]any{
+			"org_id": orgId,
+		})
+		if err != nil {
+			return err
+		}
+		changes, err = s.readScheduledQuotaChanges(res)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func (s *scheduledQuotaChangeNeo4jStore) ListDue(tx domain.Tx, now time.Time) ([]domain.ScheduledQuotaChange, error) {
+	var changes []domain.ScheduledQuotaChange
+	err := atomic(s.driver, s.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(listDueScheduledQuotaChangesCypher, map[string]any{
+			"status": string(domain.ScheduledQuotaChangeScheduled),
+			"now":    now.UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+		changes, err = s.readScheduledQuotaChanges(res)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func (s *scheduledQuotaChangeNeo4jStore) SetRun(tx domain.Tx, change domain.ScheduledQuotaChange) error {
+	return atomic(s.driver, s.dbName, tx, func(tx neo4j.Transaction) error {
+		res, err := tx.Run(setScheduledQuotaChangeRunCypher, map[string]any{
+			"id":         change.GetId(),
+			"properties": runProperties(change),
+		})
+		if err != nil {
+			return err
+		}
+		records, err := res.Collect()
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return fmt.Errorf("cannot find scheduled quota change %s", change.GetId())
+		}
+		return nil
+	})
+}
+
+func (s *scheduledQuotaChangeNeo4jStore) Remove(tx domain.Tx, id string) error {
+	return atomic(s.driver, s.dbName, tx, func(tx neo4j.Transaction) error {
+		_, err := tx.Run(removeScheduledQuotaChangeCypher, map[string]any{
+			"id": id,
+		})
+		return err
+	})
+}
+
+// runProperties holds the properties that change with every run, times are in unix milliseconds and zero if missing.
+func runProperties(change domain.ScheduledQuotaChange) map[string]any {
+	return map[string]any{
+		"next_run_at": unixMilli(change.GetNextRunAt()),
+		"status":      string(change.GetStatus()),
+		"last_run_at": unixMilli(change.GetLastRunAt()),
+		"last_error":  change.GetLastError(),
+		"failures":    change.GetFailures(),
+	}
+}
+
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+func fromUnixMilli(millis int64) time.Time {
+	if millis == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis)
+}
+
+func (s *scheduledQuotaChangeNeo4jStore) readScheduledQuotaChanges(res neo4j.Result) ([]domain.ScheduledQuotaChange, error) {
+	changes := make([]domain.ScheduledQuotaChange, 0)
+	if res.Err() != nil {
+		return changes, res.Err()
+	}
+	records, err := res.Collect()
+	if err != nil {
+		return changes, err
+	}
+	for _, record := range records {
+		propertiesAny, found := record.Get("properties")
+		if !found {
+			return changes, fmt.Errorf("scheduled quota change has no properties")
+		}
+		properties, ok := propertiesAny.(map[string]any)
+		if !ok {
+			return changes, fmt.Errorf("scheduled quota change has no properties")
+		}
+		change, err := readScheduledQuotaChange(properties)
+		if err != nil {
+			return changes, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func readScheduledQuotaChange(properties map[string]any) (domain.ScheduledQuotaChange, error) {
+	strs := make(map[string]string)
+	for _, key := range []string{"id", "org_id", "namespace_name", "app_name", "cron", "status", "last_error"} {
+		value, ok := properties[key].(string)
+		if !ok {
+			return domain.ScheduledQuotaChange{}, fmt.Errorf("scheduled quota change %s invalid type", key)
+		}
+		strs[key] = value
+	}
+	ints := make(map[string]int64)
+	for _, key := range []string{"next_run_at", "last_run_at", "failures"} {
+		value, ok := properties[key].(int64)
+		if !ok {
+			return domain.ScheduledQuotaChange{}, fmt.Errorf("scheduled quota change %s invalid type", key)
+		}
+		ints[key] = value
+	}
+	quotas := make(domain.ResourceQuotas)
+	for key, value := range properties {
+		resource, found := strings.CutPrefix(key, quotaPropertyPrefix)
+		if !found {
+			continue
+		}
+		if quota, ok := value.(int64); !ok {
+			log.Printf("invalid quota type for resource name %s: %v\n", resource, value)
+		} else {
+			quotas[resource] = quota
+		}
+	}
+	return domain.RestoreScheduledQuotaChange(strs["id"], strs["org_id"], strs["namespace_name"], strs["app_name"], quotas, strs["cron"],
+		fromUnixMilli(ints["next_run_at"]), domain.ScheduledQuotaChangeStatus(strs["status"]),
+		fromUnixMilli(ints["last_run_at"]), strs["last_error"], ints["failures"]), nil
+}
+
+const addScheduledQuotaChangeCypher = `
+CREATE (c:ScheduledQuotaChange{id: $id})
+SET c += $properties;
+`
+
+const getScheduledQuotaChangeCypher = `
+MATCH (c:ScheduledQuotaChange{id: $id})
+RETURN properties(c) AS properties;
+`
+
+const listScheduledQuotaChangesCypher = `
+MATCH (c:ScheduledQuotaChange{org_id: $org_id})
+RETURN properties(c) AS properties
+ORDER BY c.next_run_at = 0, c.next_run_at, c.id;
+`
+
+const listDueScheduledQuotaChangesCypher = `
+MATCH (c:ScheduledQuotaChange{status: $status})
+WHERE c.next_run_at <= $now
+RETURN properties(c) AS properties;
+`
+
+const setScheduledQuotaChangeRunCypher = `
+MATCH (c:ScheduledQuotaChange{id: $id})
+SET c += $properties
+RETURN c.id;
+`
+
+const removeScheduledQuotaChangeCypher = `
+MATCH (c:ScheduledQuotaChange{id: $id})
+DETACH DELETE c;
+`
@@ -0,0 +1,139 @@
+package workers
+
+import (
+	"time"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+// QuotaHistoryRecorder adds samples of the quotas of namespaces and apps to the quota history.
+// It runs in the transaction that changed the quotas, so that only committed changes are recorded.
+type QuotaHistoryRecorder struct {
+	history    domain.QuotaHistoryStore
+	namespaces domain.NamespaceStore
+	apps       domain.AppStore
+}
+
+func NewQuotaHistoryRecorder(history domain.QuotaHistoryStore, namespaces domain.NamespaceStore, apps domain.AppStore) *QuotaHistoryRecorder {
+	return &QuotaHistoryRecorder{
+		history:    history,
+		namespaces: namespaces,
+		apps:       apps,
+	}
+}
+
+// RecordNamespaces samples the quotas, the available and the utilized resources of the namespaces,
+// empty ids are skipped. A nil recorder records nothing.
+func (r *QuotaHistoryRecorder) RecordNamespaces(tx domain.Tx, ids ...string) error {
+	if r == nil {
+		return nil
+	}
+	now := time.Now()
+	samples := make([]domain.QuotaSample, 0)
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		namespace, err := r.namespaces.Get(tx, id)
+		if err != nil {
+			return err
+		}
+		allocated := namespace.GetResourceQuotas()
+		available := namespace.GetAvailable()
+		utilized := namespace.GetUtilized()
+		for resource := range namespaceResources(namespace) {
+			samples = append(samples, domain.QuotaSample{
+				EntityId:  id,
+				Resource:  resource,
+				Allocated: allocated[resource],
+				Available: available[resource],
+				Utilized:  utilized[resource],
+				Timestamp: now,
+			})
+		}
+	}
+	return r.history.Add(tx, samples)
+}
+
+// RecordNamespaceWithParent samples the namespace and its parent, whose utilization includes the namespace quotas.
+func (r *QuotaHistoryRecorder) RecordNamespaceWithParent(tx domain.Tx, id string) error {
+	if r == nil {
+		return nil
+	}
+	parent, err := r.namespaces.GetParent(tx, id)
+	if err != nil {
+		return err
+	}
+	if parent == nil {
+		return r.RecordNamespaces(tx, id)
+	}
+	return r.RecordNamespaces(tx, id, parent.GetId())
+}
+
+// RecordApps samples the quotas of the apps.
+func (r *QuotaHistoryRecorder) RecordApps(tx domain.Tx, ids ...string) error {
+	if r == nil {
+		return nil
+	}
+	now := time.Now()
+	samples := make([]domain.QuotaSample, 0)
+	for _, id := range ids {
+		app, err := r.apps.Get(tx, id)
+		if err != nil {
+			return err
+		}
+		for resource, quota := range app.GetResourceQuotas() {
+			samples = append(samples, domain.QuotaSample{
+				EntityId:  id,
+				Resource:  resource,
+				Allocated: quota,
+				Timestamp: now,
+			})
+		}
+	}
+	return r.history.Add(tx, samples)
+}
+
+// RecordRemovedNamespace ends the history of a removed namespace with samples of zero quotas.
+func (r *QuotaHistoryRecorder) RecordRemovedNamespace(tx domain.Tx, namespace domain.Namespace) error {
+	if r == nil {
+		return nil
+	}
+	return r.recordRemoved(tx, namespace.GetId(), namespaceResources(namespace))
+}
+
+// RecordRemovedApp ends the history of a removed app with samples of zero quotas.
+func (r *QuotaHistoryRecorder) RecordRemovedApp(tx domain.Tx, app domain.App) error {
+	if r == nil {
+		return nil
+	}
+	resources := make(map[string]bool)
+	for resource := range app.GetResourceQuotas() {
+		resources[resource] = true
+	}
+	return r.recordRemoved(tx, app.GetId(), resources)
+}
+
+func (r *QuotaHistoryRecorder) recordRemoved(tx domain.Tx, id string, resources map[string]bool) error {
+	now := time.Now()
+	samples := make([]domain.QuotaSample, 0, len(resources))
+	for resource := range resources {
+		samples = append(samples, domain.QuotaSample{
+			EntityId:  id,
+			Resource:  resource,
+			Timestamp: now,
+		})
+	}
+	return r.history.Add(tx, samples)
+}
+
+// namespaceResources returns the resources the namespace has a quota of or that its children use.
+func namespaceResources(namespace domain.Namespace) map[string]bool {
+	resources := make(map[string]bool)
+	for _, quotas := range []domain.ResourceQuotas{namespace.GetResourceQuotas(), namespace.GetAvailable(), namespace.GetUtilized()} {
+		for resource := range quotas {
+			resources[resource] = true
+		}
+	}
+	return resources
+}
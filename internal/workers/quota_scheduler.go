@@ -0,0 +1,118 @@
+package workers
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"time"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+// QuotaScheduler applies the scheduled quota changes that are due. Changes that cannot be
+// applied are not retried, the failure is recorded on the change instead.
+type QuotaScheduler struct {
+	schedules  domain.ScheduledQuotaChangeStore
+	namespaces domain.NamespaceStore
+	apps       domain.AppStore
+	resources  domain.ResourceQuotaStore
+	txManager  domain.TxManager
+	recorder   *QuotaHistoryRecorder
+	alerter    *UtilizationAlerter
+	interval   time.Duration
+}
+
+func NewQuotaScheduler(stores Stores, observers Observers, interval time.Duration) *QuotaScheduler {
+	if interval <= 0 {
+		log.Fatalln("interval must be positive while initializing quota scheduler")
+	}
+	return &QuotaScheduler{
+		schedules:  stores.Schedules,
+		namespaces: stores.Namespaces,
+		apps:       stores.Apps,
+		resources:  stores.Resources,
+		txManager:  stores.TxManager,
+		recorder:   observers.Recorder,
+		alerter:    observers.Alerter,
+		interval:   interval,
+	}
+}
+
+// Run applies the due changes on every tick until stop is closed.
+func (s *QuotaScheduler) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			if err := s.RunDue(now); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+// RunDue applies every change that is due by now in a transaction of its own and records the outcome.
+func (s *QuotaScheduler) RunDue(now time.Time) error {
+	due, err := s.schedules.ListDue(nil, now)
+	if err != nil {
+		return err
+	}
+	for _, change := range due {
+		err := s.txManager.Atomic(func(tx domain.Tx) error {
+			return s.apply(tx, change)
+		})
+		if err != nil {
+			log.Printf("scheduled quota change %s of %s failed: %v", change.GetId(), change.GetEntityId(), err)
+		} else if change.GetAppName() != "" {
+			s.alerter.Check(domain.MakeNamespaceId(change.GetOrgId(), change.GetNamespaceName()))
+		} else {
+			s.alerter.CheckWithParent(change.GetEntityId())
+		}
+		change.RecordRun(now, err)
+		if err := s.schedules.SetRun(nil, change); err != nil {
+			log.Println(err)
+		}
+	}
+	return nil
+}
+
+// apply sets the quotas with the same checks as a direct change would go through.
+func (s *QuotaScheduler) apply(tx domain.Tx, change domain.ScheduledQuotaChange) error {
+	quotas := change.GetResourceQuotas()
+	if change.GetAppName() != "" {
+		app, err := s.apps.Get(tx, change.GetEntityId())
+		if err != nil {
+			return fmt.Errorf("app %s not found", change.GetAppName())
+		}
+		namespace, err := s.namespaces.Get(tx, app.GetNamespace().GetId())
+		if err != nil {
+			return err
+		}
+		updated := app.GetResourceQuotas()
+		maps.Copy(updated, quotas)
+		err = namespace.GetLimitRange().Check(updated)
+		if err != nil {
+			return err
+		}
+		err = s.resources.SetResourceQuotas(tx, change.GetEntityId(), quotas)
+		if err != nil {
+			return err
+		}
+		err = s.recorder.RecordApps(tx, change.GetEntityId())
+		if err != nil {
+			return err
+		}
+		return s.recorder.RecordNamespaces(tx, namespace.GetId())
+	}
+	if _, err := s.namespaces.Get(tx, change.GetEntityId()); err != nil {
+		return fmt.Errorf("namespace %s not found", change.GetNamespaceName())
+	}
+	err := s.resources.SetResourceQuotas(tx, change.GetEntityId(), quotas)
+	if err != nil {
+		return err
+	}
+	return s.recorder.RecordNamespaceWithParent(tx, change.GetEntityId())
+}
@@ -0,0 +1,84 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/c12s/meridian/internal/domain"
+	"github.com/c12s/meridian/internal/store"
+)
+
+func TestQuotaSchedulerRunDue(t *testing.T) {
+	db := store.NewMemoryDb()
+	namespaces := store.NewNamespaceMemoryStore(db)
+	schedules := store.NewScheduledQuotaChangeMemoryStore(db)
+	scheduler := NewQuotaScheduler(Stores{
+		Namespaces: namespaces,
+		Apps:       store.NewAppMemoryStore(db),
+		Resources:  store.NewResourceQuotaMemoryStore(db),
+		Schedules:  schedules,
+		TxManager:  store.NewMemoryTxManager(db),
+	}, Observers{}, time.Minute)
+
+	parent, child := domain.NewNamespace("org", "a", "", nil), domain.NewNamespace("org", "b", "", nil)
+	if err := parent.AddResourceQuota("cpu", 10); err != nil {
+		t.Fatalf("AddResourceQuota() error = %v", err)
+	}
+	if err := namespaces.Add(nil, parent, nil); err != nil {
+		t.Fatalf("Add(a) error = %v", err)
+	}
+	if err := namespaces.Add(nil, child, &parent); err != nil {
+		t.Fatalf("Add(b) error = %v", err)
+	}
+
+	now := time.Now()
+	schedule := func(cpu int64, runAt time.Time) domain.ScheduledQuotaChange {
+		change, err := domain.NewScheduledQuotaChange("org", "b", "", domain.ResourceQuotas{"cpu": cpu}, "", runAt)
+		if err != nil {
+			t.Fatalf("NewScheduledQuotaChange() error = %v", err)
+		}
+		if err := schedules.Add(nil, change); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+		return change
+	}
+	applied := schedule(4, now.Add(-time.Minute))
+	failed := schedule(20, now.Add(-time.Second))
+	later := schedule(2, now.Add(time.Hour))
+
+	if err := scheduler.RunDue(now); err != nil {
+		t.Fatalf("RunDue() error = %v", err)
+	}
+	tests := []struct {
+		name         string
+		id           string
+		wantStatus   domain.ScheduledQuotaChangeStatus
+		wantFailures int64
+	}{
+		{name: "applied change", id: applied.GetId(), wantStatus: domain.ScheduledQuotaChangeDone},
+		{name: "change above the parent available", id: failed.GetId(), wantStatus: domain.ScheduledQuotaChangeFailed, wantFailures: 1},
+		{name: "change that is not due", id: later.GetId(), wantStatus: domain.ScheduledQuotaChangeScheduled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change, err := schedules.Get(nil, tt.id)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if change.GetStatus() != tt.wantStatus || change.GetFailures() != tt.wantFailures {
+				t.Errorf("status = %v, failures = %v, want %v, %v", change.GetStatus(), change.GetFailures(), tt.wantStatus, tt.wantFailures)
+			}
+			if (change.GetLastError() != "") != (tt.wantFailures > 0) {
+				t.Errorf("last error = %q, want one only for failed runs", change.GetLastError())
+			}
+		})
+	}
+	namespace, err := namespaces.Get(nil, child.GetId())
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	// the failed change leaves the applied quota in place
+	if got := namespace.GetResourceQuotas()["cpu"]; got != 4 {
+		t.Errorf("cpu quota = %v, want 4", got)
+	}
+}
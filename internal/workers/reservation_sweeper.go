@@ -0,0 +1,72 @@
+package workers
+
+import (
+	"log"
+	"time"
+
+	"github.com/c12s/meridian/internal/domain"
+)
+
+// ReservationSweeper removes expired reservations, which releases the quotas they hold.
+type ReservationSweeper struct {
+	reservations domain.ReservationStore
+	txManager    domain.TxManager
+	recorder     *QuotaHistoryRecorder
+	alerter      *UtilizationAlerter
+	interval     time.Duration
+}
+
+func NewReservationSweeper(stores Stores, observers Observers, interval time.Duration) *ReservationSweeper {
+	if interval <= 0 {
+		log.Fatalln("interval must be positive while initializing reservation sweeper")
+	}
+	return &ReservationSweeper{
+		reservations: stores.Reservations,
+		txManager:    stores.TxManager,
+		recorder:     observers.Recorder,
+		alerter:      observers.Alerter,
+		interval:     interval,
+	}
+}
+
+// Run sweeps the expired reservations on every tick until stop is closed.
+func (s *ReservationSweeper) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			if err := s.Sweep(now); err != nil {
+				log.Println(err)
+			}
+		}
+	}
+}
+
+// Sweep removes the reservations that expired by now in a single transaction.
+func (s *ReservationSweeper) Sweep(now time.Time) error {
+	var released []string
+	err := s.txManager.Atomic(func(tx domain.Tx) error {
+		released = nil
+		expired, err := s.reservations.ListExpired(tx, now)
+		if err != nil {
+			return err
+		}
+		for _, reservation := range expired {
+			err := s.reservations.Remove(tx, reservation.GetId())
+			if err != nil {
+				return err
+			}
+			log.Printf("reservation %s expired at %s", reservation.GetId(), reservation.GetExpiresAt().Format(time.RFC3339))
+			released = append(released, reservation.GetNamespace().GetId())
+		}
+		return s.recorder.RecordNamespaces(tx, released...)
+	})
+	if err != nil {
+		return err
+	}
+	s.alerter.Check(released...)
+	return nil
+}
@@ -0,0 +1,131 @@
+package workers
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/c12s/magnetar/pkg/messaging"
+	"github.com/c12s/meridian/internal/domain"
+	"github.com/c12s/meridian/pkg/api"
+	"google.golang.org/protobuf/proto"
+)
+
+// UtilizationAlerter publishes an event whenever the utilization of a namespace resource
+// crosses one of its thresholds. The last known levels are kept in memory, so after a restart
+// the resources above their thresholds are reported again on the first check.
+type UtilizationAlerter struct {
+	publisher  messaging.Publisher
+	namespaces domain.NamespaceStore
+	mu         sync.Mutex
+	// levels other than ok by namespace id and resource
+	levels map[string]map[string]domain.AlertLevel
+}
+
+func NewUtilizationAlerter(publisher messaging.Publisher, namespaces domain.NamespaceStore) *UtilizationAlerter {
+	return &UtilizationAlerter{
+		publisher:  publisher,
+		namespaces: namespaces,
+		levels:     make(map[string]map[string]domain.AlertLevel),
+	}
+}
+
+// Check compares the utilization of the namespaces with their thresholds and
+// publishes the changes of the alert levels. It has to be called after quota
+// mutations are committed, a nil alerter does nothing.
+func (a *UtilizationAlerter) Check(namespaceIds ...string) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, id := range namespaceIds {
+		if id == "" {
+			continue
+		}
+		namespace, err := a.namespaces.Get(nil, id)
+		if err != nil {
+			// removed namespaces have no utilization to alert on
+			delete(a.levels, id)
+			continue
+		}
+		a.check(namespace)
+	}
+}
+
+// CheckWithParent checks the namespace and its parent, whose utilization includes the namespace quotas.
+func (a *UtilizationAlerter) CheckWithParent(namespaceId string) {
+	if a == nil {
+		return
+	}
+	parent, err := a.namespaces.GetParent(nil, namespaceId)
+	if err != nil {
+		log.Println(err)
+	}
+	if parent == nil {
+		a.Check(namespaceId)
+		return
+	}
+	a.Check(namespaceId, parent.GetId())
+}
+
+func (a *UtilizationAlerter) check(namespace domain.Namespace) {
+	id := namespace.GetId()
+	previous := a.levels[id]
+	current := namespace.GetAlertLevels()
+	// resources whose thresholds were removed are back to ok
+	for resource := range previous {
+		if _, found := current[resource]; !found {
+			current[resource] = domain.AlertLevelOk
+		}
+	}
+	utilized := namespace.GetUtilized()
+	effective := namespace.GetEffective()
+	levels := make(map[string]domain.AlertLevel)
+	for resource, level := range current {
+		previousLevel, found := previous[resource]
+		if !found {
+			previousLevel = domain.AlertLevelOk
+		}
+		if level != previousLevel {
+			a.publish(namespace, resource, level, previousLevel, utilized[resource], effective[resource])
+		}
+		if level != domain.AlertLevelOk {
+			levels[resource] = level
+		}
+	}
+	if len(levels) == 0 {
+		delete(a.levels, id)
+		return
+	}
+	a.levels[id] = levels
+}
+
+func (a *UtilizationAlerter) publish(namespace domain.Namespace, resource string, level, previousLevel domain.AlertLevel, utilized, effective int64) {
+	event := &api.UtilizationAlertEvent{
+		OrgId:         namespace.GetOrgId(),
+		NamespaceName: namespace.GetName(),
+		Resource:      resource,
+		Level:         string(level),
+		PreviousLevel: string(previousLevel),
+		Utilized:      utilized,
+		Effective:     effective,
+		Timestamp:     time.Now().Unix(),
+	}
+	if effective > 0 {
+		event.Utilization = float64(utilized) * 100 / float64(effective)
+	}
+	log.Printf("utilization of %s in namespace %s changed from %s to %s", resource, namespace.GetId(), previousLevel, level)
+	msg, err := proto.Marshal(event)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if a.publisher == nil {
+		return
+	}
+	err = a.publisher.Publish(msg, api.UtilizationAlertSubject(namespace.GetOrgId()))
+	if err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,21 @@
+package workers
+
+import "github.com/c12s/meridian/internal/domain"
+
+// Stores holds the stores the workers use, all of them have to be backed by the TxManager.
+// Workers only use the stores they need, the others can be left out.
+type Stores struct {
+	Namespaces   domain.NamespaceStore
+	Apps         domain.AppStore
+	Resources    domain.ResourceQuotaStore
+	Reservations domain.ReservationStore
+	Schedules    domain.ScheduledQuotaChangeStore
+	TxManager    domain.TxManager
+}
+
+// Observers are notified of the quota changes once they are committed,
+// by the handlers and by the workers that change quotas. Observers left out do nothing.
+type Observers struct {
+	Alerter  *UtilizationAlerter
+	Recorder *QuotaHistoryRecorder
+}
@@ -143,12 +143,17 @@ type ApplyAppConfigCommand struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrgId          string             `protobuf:"bytes,1,opt,name=orgId,proto3" json:"orgId,omitempty"`
-	NamespaceName  string             `protobuf:"bytes,2,opt,name=namespaceName,proto3" json:"namespaceName,omitempty"`
-	AppName        string             `protobuf:"bytes,3,opt,name=appName,proto3" json:"appName,omitempty"`
-	SeccompProfile string             `protobuf:"bytes,4,opt,name=seccompProfile,proto3" json:"seccompProfile,omitempty"`
-	Quotas         map[string]float64 `protobuf:"bytes,5,rep,name=quotas,proto3" json:"quotas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	Strategy       string             `protobuf:"bytes,6,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	OrgId          string `protobuf:"bytes,1,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	NamespaceName  string `protobuf:"bytes,2,opt,name=namespaceName,proto3" json:"namespaceName,omitempty"`
+	AppName        string `protobuf:"bytes,3,opt,name=appName,proto3" json:"appName,omitempty"`
+	SeccompProfile string `protobuf:"bytes,4,opt,name=seccompProfile,proto3" json:"seccompProfile,omitempty"`
+	// deprecated, in units of the resource types, use quotasMilli
+	//
+	// Deprecated: Do not use.
+	Quotas   map[string]float64 `protobuf:"bytes,5,rep,name=quotas,proto3" json:"quotas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Strategy string             `protobuf:"bytes,6,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// in milli units of the resource types
+	QuotasMilli map[string]int64 `protobuf:"bytes,7,rep,name=quotasMilli,proto3" json:"quotasMilli,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (x *ApplyAppConfigCommand) Reset() {
@@ -211,6 +216,7 @@ func (x *ApplyAppConfigCommand) GetSeccompProfile() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (x *ApplyAppConfigCommand) GetQuotas() map[string]float64 {
 	if x != nil {
 		return x.Quotas
@@ -225,6 +231,194 @@ func (x *ApplyAppConfigCommand) GetStrategy() string {
 	return ""
 }
 
+func (x *ApplyAppConfigCommand) GetQuotasMilli() map[string]int64 {
+	if x != nil {
+		return x.QuotasMilli
+	}
+	return nil
+}
+
+// published on the removal subject of the nodes the app config was disseminated to,
+// agents that do not subscribe to it keep ignoring removals instead of misreading them
+type RemoveAppConfigCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId         string `protobuf:"bytes,1,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	NamespaceName string `protobuf:"bytes,2,opt,name=namespaceName,proto3" json:"namespaceName,omitempty"`
+	AppName       string `protobuf:"bytes,3,opt,name=appName,proto3" json:"appName,omitempty"`
+}
+
+func (x *RemoveAppConfigCommand) Reset() {
+	*x = RemoveAppConfigCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meridian_model_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAppConfigCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAppConfigCommand) ProtoMessage() {}
+
+func (x *RemoveAppConfigCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_meridian_model_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAppConfigCommand.ProtoReflect.Descriptor instead.
+func (*RemoveAppConfigCommand) Descriptor() ([]byte, []int) {
+	return file_meridian_model_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveAppConfigCommand) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RemoveAppConfigCommand) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+func (x *RemoveAppConfigCommand) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+// published when the utilization of a namespace resource crosses a threshold
+type UtilizationAlertEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId         string `protobuf:"bytes,1,opt,name=orgId,proto3" json:"orgId,omitempty"`
+	NamespaceName string `protobuf:"bytes,2,opt,name=namespaceName,proto3" json:"namespaceName,omitempty"`
+	Resource      string `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	// ok, warning or critical
+	Level         string `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
+	PreviousLevel string `protobuf:"bytes,5,opt,name=previousLevel,proto3" json:"previousLevel,omitempty"`
+	// in milli units of the resource type
+	Utilized  int64 `protobuf:"varint,6,opt,name=utilized,proto3" json:"utilized,omitempty"`
+	Effective int64 `protobuf:"varint,7,opt,name=effective,proto3" json:"effective,omitempty"`
+	// percentage of the effective capacity, zero if the namespace has no effective capacity
+	Utilization float64 `protobuf:"fixed64,8,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	// unix seconds
+	Timestamp int64 `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *UtilizationAlertEvent) Reset() {
+	*x = UtilizationAlertEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_meridian_model_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtilizationAlertEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtilizationAlertEvent) ProtoMessage() {}
+
+func (x *UtilizationAlertEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_meridian_model_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtilizationAlertEvent.ProtoReflect.Descriptor instead.
+func (*UtilizationAlertEvent) Descriptor() ([]byte, []int) {
+	return file_meridian_model_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UtilizationAlertEvent) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *UtilizationAlertEvent) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+func (x *UtilizationAlertEvent) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *UtilizationAlertEvent) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *UtilizationAlertEvent) GetPreviousLevel() string {
+	if x != nil {
+		return x.PreviousLevel
+	}
+	return ""
+}
+
+func (x *UtilizationAlertEvent) GetUtilized() int64 {
+	if x != nil {
+		return x.Utilized
+	}
+	return 0
+}
+
+func (x *UtilizationAlertEvent) GetEffective() int64 {
+	if x != nil {
+		return x.Effective
+	}
+	return 0
+}
+
+func (x *UtilizationAlertEvent) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *UtilizationAlertEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
 var File_meridian_model_proto protoreflect.FileDescriptor
 
 var file_meridian_model_proto_rawDesc = []byte{
@@ -241,7 +435,7 @@ var file_meridian_model_proto_rawDesc = []byte{
 	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
 	0x08, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x52,
-	0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0xae, 0x02,
+	0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0xc3, 0x03,
 	0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x24, 0x0a,
@@ -251,19 +445,54 @@ var file_meridian_model_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
 	0x0e, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x6d, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
 	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x63, 0x63, 0x6f, 0x6d, 0x70, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18,
 	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70,
 	0x70, 0x6c, 0x79, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x1a, 0x39, 0x0a, 0x0b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x22,
-	0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x31, 0x32,
-	0x73, 0x2f, 0x6d, 0x65, 0x72, 0x69, 0x64, 0x69, 0x61, 0x6e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
-	0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6e, 0x64, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x4f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x73, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x73, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x73, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x1a, 0x39, 0x0a, 0x0b, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x4d, 0x69, 0x6c,
+	0x6c, 0x69, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x6e, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72,
+	0x67, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0xa5, 0x02, 0x0a, 0x15, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72,
+	0x67, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75,
+	0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x22, 0x5a, 0x20, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x31, 0x32, 0x73, 0x2f, 0x6d,
+	0x65, 0x72, 0x69, 0x64, 0x69, 0x61, 0x6e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -278,21 +507,25 @@ func file_meridian_model_proto_rawDescGZIP() []byte {
 	return file_meridian_model_proto_rawDescData
 }
 
-var file_meridian_model_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_meridian_model_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_meridian_model_proto_goTypes = []interface{}{
-	(*SyscallRule)(nil),           // 0: proto.SyscallRule
-	(*SeccompProfile)(nil),        // 1: proto.SeccompProfile
-	(*ApplyAppConfigCommand)(nil), // 2: proto.ApplyAppConfigCommand
-	nil,                           // 3: proto.ApplyAppConfigCommand.QuotasEntry
+	(*SyscallRule)(nil),            // 0: proto.SyscallRule
+	(*SeccompProfile)(nil),         // 1: proto.SeccompProfile
+	(*ApplyAppConfigCommand)(nil),  // 2: proto.ApplyAppConfigCommand
+	(*RemoveAppConfigCommand)(nil), // 3: proto.RemoveAppConfigCommand
+	(*UtilizationAlertEvent)(nil),  // 4: proto.UtilizationAlertEvent
+	nil,                            // 5: proto.ApplyAppConfigCommand.QuotasEntry
+	nil,                            // 6: proto.ApplyAppConfigCommand.QuotasMilliEntry
 }
 var file_meridian_model_proto_depIdxs = []int32{
 	0, // 0: proto.SeccompProfile.syscalls:type_name -> proto.SyscallRule
-	3, // 1: proto.ApplyAppConfigCommand.quotas:type_name -> proto.ApplyAppConfigCommand.QuotasEntry
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 1: proto.ApplyAppConfigCommand.quotas:type_name -> proto.ApplyAppConfigCommand.QuotasEntry
+	6, // 2: proto.ApplyAppConfigCommand.quotasMilli:type_name -> proto.ApplyAppConfigCommand.QuotasMilliEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_meridian_model_proto_init() }
@@ -337,6 +570,30 @@ func file_meridian_model_proto_init() {
 				return nil
 			}
 		}
+		file_meridian_model_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAppConfigCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_meridian_model_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtilizationAlertEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -344,7 +601,7 @@ func file_meridian_model_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_meridian_model_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -11,8 +11,10 @@ import (
 )
 
 type MeridianAsyncClient struct {
-	subscriber messaging.Subscriber
-	publisher  messaging.Publisher
+	subscriber        messaging.Subscriber
+	removalSubscriber messaging.Subscriber
+	receivesRemovals  bool
+	publisher         messaging.Publisher
 }
 
 func NewMeridianAsyncClient(address, nodeId string) (*MeridianAsyncClient, error) {
@@ -24,17 +26,36 @@ func NewMeridianAsyncClient(address, nodeId string) (*MeridianAsyncClient, error
 	if err != nil {
 		return nil, err
 	}
+	removalSubscriber, err := nats.NewSubscriber(conn, RemoveSubject(nodeId), nodeId)
+	if err != nil {
+		return nil, err
+	}
 	publisher, err := nats.NewPublisher(conn)
 	if err != nil {
 		return nil, err
 	}
 	return &MeridianAsyncClient{
-		subscriber: subscriber,
-		publisher:  publisher,
+		subscriber:        subscriber,
+		removalSubscriber: removalSubscriber,
+		publisher:         publisher,
 	}, nil
 }
 
+// ReceiveConfig passes the quotas to the handler in units of the resource types, use ReceiveMilliConfig
+// to receive them in milli units without rounding.
 func (c *MeridianAsyncClient) ReceiveConfig(handler ApplyAppConfigHandler) error {
+	return c.receive(func(cmd *ApplyAppConfigCommand) error {
+		return handler(cmd.OrgId, cmd.NamespaceName, cmd.AppName, cmd.SeccompProfile, cmd.Strategy, cmd.Quotas)
+	})
+}
+
+func (c *MeridianAsyncClient) ReceiveMilliConfig(handler ApplyAppConfigMilliHandler) error {
+	return c.receive(func(cmd *ApplyAppConfigCommand) error {
+		return handler(cmd.OrgId, cmd.NamespaceName, cmd.AppName, cmd.SeccompProfile, cmd.Strategy, cmd.QuotasMilli)
+	})
+}
+
+func (c *MeridianAsyncClient) receive(apply func(cmd *ApplyAppConfigCommand) error) error {
 	return c.subscriber.Subscribe(func(msg []byte, replySubject string) {
 		cmd := &ApplyAppConfigCommand{}
 		err := proto.Unmarshal(msg, cmd)
@@ -42,11 +63,32 @@ func (c *MeridianAsyncClient) ReceiveConfig(handler ApplyAppConfigHandler) error
 			log.Println(err)
 			return
 		}
-		err = handler(cmd.OrgId, cmd.NamespaceName, cmd.AppName, cmd.SeccompProfile, cmd.Strategy, cmd.Quotas)
+		err = apply(cmd)
+		if err != nil {
+			log.Println(err)
+		}
+	})
+}
+
+// ReceiveRemovals handles the commands for removing the configs of removed apps from the node.
+func (c *MeridianAsyncClient) ReceiveRemovals(handler RemoveAppConfigHandler) error {
+	err := c.removalSubscriber.Subscribe(func(msg []byte, replySubject string) {
+		cmd := &RemoveAppConfigCommand{}
+		err := proto.Unmarshal(msg, cmd)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		err = handler(cmd.OrgId, cmd.NamespaceName, cmd.AppName)
 		if err != nil {
 			log.Println(err)
 		}
 	})
+	if err != nil {
+		return err
+	}
+	c.receivesRemovals = true
+	return nil
 }
 
 func (c *MeridianAsyncClient) GracefulStop() {
@@ -54,10 +96,31 @@ func (c *MeridianAsyncClient) GracefulStop() {
 	if err != nil {
 		log.Println(err)
 	}
+	if c.receivesRemovals {
+		err = c.removalSubscriber.Unsubscribe()
+		if err != nil {
+			log.Println(err)
+		}
+	}
 }
 
 type ApplyAppConfigHandler func(orgId, namespaceName, appName, seccompProfile, strategy string, quotas map[string]float64) error
 
+// ApplyAppConfigMilliHandler receives the quotas in milli units of the resource types.
+type ApplyAppConfigMilliHandler func(orgId, namespaceName, appName, seccompProfile, strategy string, quotas map[string]int64) error
+
+type RemoveAppConfigHandler func(orgId, namespaceName, appName string) error
+
 func Subject(nodeId string) string {
 	return fmt.Sprintf("%s.app_config", nodeId)
 }
+
+// RemoveSubject is kept apart from Subject so that removals never reach agents that only apply configs.
+func RemoveSubject(nodeId string) string {
+	return fmt.Sprintf("%s.app_config.remove", nodeId)
+}
+
+// UtilizationAlertSubject is the subject of the UtilizationAlertEvent messages of the org.
+func UtilizationAlertSubject(orgId string) string {
+	return fmt.Sprintf("%s.quota_alerts", orgId)
+}
@@ -24,12 +24,43 @@ const _ = grpc.SupportPackageIsVersion7
 type MeridianClient interface {
 	AddNamespace(ctx context.Context, in *AddNamespaceReq, opts ...grpc.CallOption) (*AddNamespaceResp, error)
 	RemoveNamespace(ctx context.Context, in *RemoveNamespaceReq, opts ...grpc.CallOption) (*RemoveNamespaceResp, error)
+	MoveNamespace(ctx context.Context, in *MoveNamespaceReq, opts ...grpc.CallOption) (*MoveNamespaceResp, error)
+	UpdateNamespace(ctx context.Context, in *UpdateNamespaceReq, opts ...grpc.CallOption) (*UpdateNamespaceResp, error)
 	AddApp(ctx context.Context, in *AddAppReq, opts ...grpc.CallOption) (*AddAppResp, error)
 	RemoveApp(ctx context.Context, in *RemoveAppReq, opts ...grpc.CallOption) (*RemoveAppResp, error)
+	ReserveQuota(ctx context.Context, in *ReserveQuotaReq, opts ...grpc.CallOption) (*ReserveQuotaResp, error)
+	ListReservations(ctx context.Context, in *ListReservationsReq, opts ...grpc.CallOption) (*ListReservationsResp, error)
+	CancelReservation(ctx context.Context, in *CancelReservationReq, opts ...grpc.CallOption) (*CancelReservationResp, error)
+	GetApp(ctx context.Context, in *GetAppReq, opts ...grpc.CallOption) (*GetAppResp, error)
+	ListApps(ctx context.Context, in *ListAppsReq, opts ...grpc.CallOption) (*ListAppsResp, error)
 	GetNamespace(ctx context.Context, in *GetNamespaceReq, opts ...grpc.CallOption) (*GetNamespaceResp, error)
+	ListNamespaces(ctx context.Context, in *ListNamespacesReq, opts ...grpc.CallOption) (*ListNamespacesResp, error)
 	GetNamespaceHierarchy(ctx context.Context, in *GetNamespaceHierarchyReq, opts ...grpc.CallOption) (*GetNamespaceHierarchyResp, error)
 	SetNamespaceResources(ctx context.Context, in *SetNamespaceResourcesReq, opts ...grpc.CallOption) (*SetNamespaceResourcesResp, error)
+	CreateQuotaRequest(ctx context.Context, in *CreateQuotaRequestReq, opts ...grpc.CallOption) (*CreateQuotaRequestResp, error)
+	ListQuotaRequests(ctx context.Context, in *ListQuotaRequestsReq, opts ...grpc.CallOption) (*ListQuotaRequestsResp, error)
+	ApproveQuotaRequest(ctx context.Context, in *ApproveQuotaRequestReq, opts ...grpc.CallOption) (*ApproveQuotaRequestResp, error)
+	RejectQuotaRequest(ctx context.Context, in *RejectQuotaRequestReq, opts ...grpc.CallOption) (*RejectQuotaRequestResp, error)
 	SetAppResources(ctx context.Context, in *SetAppResourcesReq, opts ...grpc.CallOption) (*SetAppResourcesResp, error)
+	TransferQuota(ctx context.Context, in *TransferQuotaReq, opts ...grpc.CallOption) (*TransferQuotaResp, error)
+	GetQuotaHistory(ctx context.Context, in *GetQuotaHistoryReq, opts ...grpc.CallOption) (*GetQuotaHistoryResp, error)
+	ScheduleQuotaChange(ctx context.Context, in *ScheduleQuotaChangeReq, opts ...grpc.CallOption) (*ScheduleQuotaChangeResp, error)
+	ListScheduledQuotaChanges(ctx context.Context, in *ListScheduledQuotaChangesReq, opts ...grpc.CallOption) (*ListScheduledQuotaChangesResp, error)
+	CancelScheduledQuotaChange(ctx context.Context, in *CancelScheduledQuotaChangeReq, opts ...grpc.CallOption) (*CancelScheduledQuotaChangeResp, error)
+	SetNamespaceOvercommit(ctx context.Context, in *SetNamespaceOvercommitReq, opts ...grpc.CallOption) (*SetNamespaceOvercommitResp, error)
+	SetNamespaceElasticQuotas(ctx context.Context, in *SetNamespaceElasticQuotasReq, opts ...grpc.CallOption) (*SetNamespaceElasticQuotasResp, error)
+	SetNamespaceUtilizationThresholds(ctx context.Context, in *SetNamespaceUtilizationThresholdsReq, opts ...grpc.CallOption) (*SetNamespaceUtilizationThresholdsResp, error)
+	SetNamespaceLimitRange(ctx context.Context, in *SetNamespaceLimitRangeReq, opts ...grpc.CallOption) (*SetNamespaceLimitRangeResp, error)
+	SetNamespaceCountQuotas(ctx context.Context, in *SetNamespaceCountQuotasReq, opts ...grpc.CallOption) (*SetNamespaceCountQuotasResp, error)
+	PutResourceType(ctx context.Context, in *PutResourceTypeReq, opts ...grpc.CallOption) (*PutResourceTypeResp, error)
+	ListResourceTypes(ctx context.Context, in *ListResourceTypesReq, opts ...grpc.CallOption) (*ListResourceTypesResp, error)
+	RemoveResourceType(ctx context.Context, in *RemoveResourceTypeReq, opts ...grpc.CallOption) (*RemoveResourceTypeResp, error)
+	PutResourcePrice(ctx context.Context, in *PutResourcePriceReq, opts ...grpc.CallOption) (*PutResourcePriceResp, error)
+	ListResourcePrices(ctx context.Context, in *ListResourcePricesReq, opts ...grpc.CallOption) (*ListResourcePricesResp, error)
+	RemoveResourcePrice(ctx context.Context, in *RemoveResourcePriceReq, opts ...grpc.CallOption) (*RemoveResourcePriceResp, error)
+	GetCostReport(ctx context.Context, in *GetCostReportReq, opts ...grpc.CallOption) (*GetCostReportResp, error)
+	GetUtilizationReport(ctx context.Context, in *GetUtilizationReportReq, opts ...grpc.CallOption) (*GetUtilizationReportResp, error)
+	Simulate(ctx context.Context, in *SimulateReq, opts ...grpc.CallOption) (*SimulateResp, error)
 }
 
 type meridianClient struct {
@@ -58,6 +89,24 @@ func (c *meridianClient) RemoveNamespace(ctx context.Context, in *RemoveNamespac
 	return out, nil
 }
 
+func (c *meridianClient) MoveNamespace(ctx context.Context, in *MoveNamespaceReq, opts ...grpc.CallOption) (*MoveNamespaceResp, error) {
+	out := new(MoveNamespaceResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/MoveNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) UpdateNamespace(ctx context.Context, in *UpdateNamespaceReq, opts ...grpc.CallOption) (*UpdateNamespaceResp, error) {
+	out := new(UpdateNamespaceResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/UpdateNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *meridianClient) AddApp(ctx context.Context, in *AddAppReq, opts ...grpc.CallOption) (*AddAppResp, error) {
 	out := new(AddAppResp)
 	err := c.cc.Invoke(ctx, "/proto.Meridian/AddApp", in, out, opts...)
@@ -76,6 +125,51 @@ func (c *meridianClient) RemoveApp(ctx context.Context, in *RemoveAppReq, opts .
 	return out, nil
 }
 
+func (c *meridianClient) ReserveQuota(ctx context.Context, in *ReserveQuotaReq, opts ...grpc.CallOption) (*ReserveQuotaResp, error) {
+	out := new(ReserveQuotaResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/ReserveQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) ListReservations(ctx context.Context, in *ListReservationsReq, opts ...grpc.CallOption) (*ListReservationsResp, error) {
+	out := new(ListReservationsResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/ListReservations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) CancelReservation(ctx context.Context, in *CancelReservationReq, opts ...grpc.CallOption) (*CancelReservationResp, error) {
+	out := new(CancelReservationResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/CancelReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) GetApp(ctx context.Context, in *GetAppReq, opts ...grpc.CallOption) (*GetAppResp, error) {
+	out := new(GetAppResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/GetApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) ListApps(ctx context.Context, in *ListAppsReq, opts ...grpc.CallOption) (*ListAppsResp, error) {
+	out := new(ListAppsResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/ListApps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *meridianClient) GetNamespace(ctx context.Context, in *GetNamespaceReq, opts ...grpc.CallOption) (*GetNamespaceResp, error) {
 	out := new(GetNamespaceResp)
 	err := c.cc.Invoke(ctx, "/proto.Meridian/GetNamespace", in, out, opts...)
@@ -85,6 +179,15 @@ func (c *meridianClient) GetNamespace(ctx context.Context, in *GetNamespaceReq,
 	return out, nil
 }
 
+func (c *meridianClient) ListNamespaces(ctx context.Context, in *ListNamespacesReq, opts ...grpc.CallOption) (*ListNamespacesResp, error) {
+	out := new(ListNamespacesResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/ListNamespaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *meridianClient) GetNamespaceHierarchy(ctx context.Context, in *GetNamespaceHierarchyReq, opts ...grpc.CallOption) (*GetNamespaceHierarchyResp, error) {
 	out := new(GetNamespaceHierarchyResp)
 	err := c.cc.Invoke(ctx, "/proto.Meridian/GetNamespaceHierarchy", in, out, opts...)
@@ -103,6 +206,42 @@ func (c *meridianClient) SetNamespaceResources(ctx context.Context, in *SetNames
 	return out, nil
 }
 
+func (c *meridianClient) CreateQuotaRequest(ctx context.Context, in *CreateQuotaRequestReq, opts ...grpc.CallOption) (*CreateQuotaRequestResp, error) {
+	out := new(CreateQuotaRequestResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/CreateQuotaRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) ListQuotaRequests(ctx context.Context, in *ListQuotaRequestsReq, opts ...grpc.CallOption) (*ListQuotaRequestsResp, error) {
+	out := new(ListQuotaRequestsResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/ListQuotaRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) ApproveQuotaRequest(ctx context.Context, in *ApproveQuotaRequestReq, opts ...grpc.CallOption) (*ApproveQuotaRequestResp, error) {
+	out := new(ApproveQuotaRequestResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/ApproveQuotaRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) RejectQuotaRequest(ctx context.Context, in *RejectQuotaRequestReq, opts ...grpc.CallOption) (*RejectQuotaRequestResp, error) {
+	out := new(RejectQuotaRequestResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/RejectQuotaRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *meridianClient) SetAppResources(ctx context.Context, in *SetAppResourcesReq, opts ...grpc.CallOption) (*SetAppResourcesResp, error) {
 	out := new(SetAppResourcesResp)
 	err := c.cc.Invoke(ctx, "/proto.Meridian/SetAppResources", in, out, opts...)
@@ -112,18 +251,220 @@ func (c *meridianClient) SetAppResources(ctx context.Context, in *SetAppResource
 	return out, nil
 }
 
+func (c *meridianClient) TransferQuota(ctx context.Context, in *TransferQuotaReq, opts ...grpc.CallOption) (*TransferQuotaResp, error) {
+	out := new(TransferQuotaResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/TransferQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) GetQuotaHistory(ctx context.Context, in *GetQuotaHistoryReq, opts ...grpc.CallOption) (*GetQuotaHistoryResp, error) {
+	out := new(GetQuotaHistoryResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/GetQuotaHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) ScheduleQuotaChange(ctx context.Context, in *ScheduleQuotaChangeReq, opts ...grpc.CallOption) (*ScheduleQuotaChangeResp, error) {
+	out := new(ScheduleQuotaChangeResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/ScheduleQuotaChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) ListScheduledQuotaChanges(ctx context.Context, in *ListScheduledQuotaChangesReq, opts ...grpc.CallOption) (*ListScheduledQuotaChangesResp, error) {
+	out := new(ListScheduledQuotaChangesResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/ListScheduledQuotaChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) CancelScheduledQuotaChange(ctx context.Context, in *CancelScheduledQuotaChangeReq, opts ...grpc.CallOption) (*CancelScheduledQuotaChangeResp, error) {
+	out := new(CancelScheduledQuotaChangeResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/CancelScheduledQuotaChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) SetNamespaceOvercommit(ctx context.Context, in *SetNamespaceOvercommitReq, opts ...grpc.CallOption) (*SetNamespaceOvercommitResp, error) {
+	out := new(SetNamespaceOvercommitResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/SetNamespaceOvercommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) SetNamespaceElasticQuotas(ctx context.Context, in *SetNamespaceElasticQuotasReq, opts ...grpc.CallOption) (*SetNamespaceElasticQuotasResp, error) {
+	out := new(SetNamespaceElasticQuotasResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/SetNamespaceElasticQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) SetNamespaceUtilizationThresholds(ctx context.Context, in *SetNamespaceUtilizationThresholdsReq, opts ...grpc.CallOption) (*SetNamespaceUtilizationThresholdsResp, error) {
+	out := new(SetNamespaceUtilizationThresholdsResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/SetNamespaceUtilizationThresholds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) SetNamespaceLimitRange(ctx context.Context, in *SetNamespaceLimitRangeReq, opts ...grpc.CallOption) (*SetNamespaceLimitRangeResp, error) {
+	out := new(SetNamespaceLimitRangeResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/SetNamespaceLimitRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) SetNamespaceCountQuotas(ctx context.Context, in *SetNamespaceCountQuotasReq, opts ...grpc.CallOption) (*SetNamespaceCountQuotasResp, error) {
+	out := new(SetNamespaceCountQuotasResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/SetNamespaceCountQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) PutResourceType(ctx context.Context, in *PutResourceTypeReq, opts ...grpc.CallOption) (*PutResourceTypeResp, error) {
+	out := new(PutResourceTypeResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/PutResourceType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) ListResourceTypes(ctx context.Context, in *ListResourceTypesReq, opts ...grpc.CallOption) (*ListResourceTypesResp, error) {
+	out := new(ListResourceTypesResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/ListResourceTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) RemoveResourceType(ctx context.Context, in *RemoveResourceTypeReq, opts ...grpc.CallOption) (*RemoveResourceTypeResp, error) {
+	out := new(RemoveResourceTypeResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/RemoveResourceType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) PutResourcePrice(ctx context.Context, in *PutResourcePriceReq, opts ...grpc.CallOption) (*PutResourcePriceResp, error) {
+	out := new(PutResourcePriceResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/PutResourcePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) ListResourcePrices(ctx context.Context, in *ListResourcePricesReq, opts ...grpc.CallOption) (*ListResourcePricesResp, error) {
+	out := new(ListResourcePricesResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/ListResourcePrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) RemoveResourcePrice(ctx context.Context, in *RemoveResourcePriceReq, opts ...grpc.CallOption) (*RemoveResourcePriceResp, error) {
+	out := new(RemoveResourcePriceResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/RemoveResourcePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) GetCostReport(ctx context.Context, in *GetCostReportReq, opts ...grpc.CallOption) (*GetCostReportResp, error) {
+	out := new(GetCostReportResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/GetCostReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) GetUtilizationReport(ctx context.Context, in *GetUtilizationReportReq, opts ...grpc.CallOption) (*GetUtilizationReportResp, error) {
+	out := new(GetUtilizationReportResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/GetUtilizationReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meridianClient) Simulate(ctx context.Context, in *SimulateReq, opts ...grpc.CallOption) (*SimulateResp, error) {
+	out := new(SimulateResp)
+	err := c.cc.Invoke(ctx, "/proto.Meridian/Simulate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MeridianServer is the server API for Meridian service.
 // All implementations must embed UnimplementedMeridianServer
 // for forward compatibility
 type MeridianServer interface {
 	AddNamespace(context.Context, *AddNamespaceReq) (*AddNamespaceResp, error)
 	RemoveNamespace(context.Context, *RemoveNamespaceReq) (*RemoveNamespaceResp, error)
+	MoveNamespace(context.Context, *MoveNamespaceReq) (*MoveNamespaceResp, error)
+	UpdateNamespace(context.Context, *UpdateNamespaceReq) (*UpdateNamespaceResp, error)
 	AddApp(context.Context, *AddAppReq) (*AddAppResp, error)
 	RemoveApp(context.Context, *RemoveAppReq) (*RemoveAppResp, error)
+	ReserveQuota(context.Context, *ReserveQuotaReq) (*ReserveQuotaResp, error)
+	ListReservations(context.Context, *ListReservationsReq) (*ListReservationsResp, error)
+	CancelReservation(context.Context, *CancelReservationReq) (*CancelReservationResp, error)
+	GetApp(context.Context, *GetAppReq) (*GetAppResp, error)
+	ListApps(context.Context, *ListAppsReq) (*ListAppsResp, error)
 	GetNamespace(context.Context, *GetNamespaceReq) (*GetNamespaceResp, error)
+	ListNamespaces(context.Context, *ListNamespacesReq) (*ListNamespacesResp, error)
 	GetNamespaceHierarchy(context.Context, *GetNamespaceHierarchyReq) (*GetNamespaceHierarchyResp, error)
 	SetNamespaceResources(context.Context, *SetNamespaceResourcesReq) (*SetNamespaceResourcesResp, error)
+	CreateQuotaRequest(context.Context, *CreateQuotaRequestReq) (*CreateQuotaRequestResp, error)
+	ListQuotaRequests(context.Context, *ListQuotaRequestsReq) (*ListQuotaRequestsResp, error)
+	ApproveQuotaRequest(context.Context, *ApproveQuotaRequestReq) (*ApproveQuotaRequestResp, error)
+	RejectQuotaRequest(context.Context, *RejectQuotaRequestReq) (*RejectQuotaRequestResp, error)
 	SetAppResources(context.Context, *SetAppResourcesReq) (*SetAppResourcesResp, error)
+	TransferQuota(context.Context, *TransferQuotaReq) (*TransferQuotaResp, error)
+	GetQuotaHistory(context.Context, *GetQuotaHistoryReq) (*GetQuotaHistoryResp, error)
+	ScheduleQuotaChange(context.Context, *ScheduleQuotaChangeReq) (*ScheduleQuotaChangeResp, error)
+	ListScheduledQuotaChanges(context.Context, *ListScheduledQuotaChangesReq) (*ListScheduledQuotaChangesResp, error)
+	CancelScheduledQuotaChange(context.Context, *CancelScheduledQuotaChangeReq) (*CancelScheduledQuotaChangeResp, error)
+	SetNamespaceOvercommit(context.Context, *SetNamespaceOvercommitReq) (*SetNamespaceOvercommitResp, error)
+	SetNamespaceElasticQuotas(context.Context, *SetNamespaceElasticQuotasReq) (*SetNamespaceElasticQuotasResp, error)
+	SetNamespaceUtilizationThresholds(context.Context, *SetNamespaceUtilizationThresholdsReq) (*SetNamespaceUtilizationThresholdsResp, error)
+	SetNamespaceLimitRange(context.Context, *SetNamespaceLimitRangeReq) (*SetNamespaceLimitRangeResp, error)
+	SetNamespaceCountQuotas(context.Context, *SetNamespaceCountQuotasReq) (*SetNamespaceCountQuotasResp, error)
+	PutResourceType(context.Context, *PutResourceTypeReq) (*PutResourceTypeResp, error)
+	ListResourceTypes(context.Context, *ListResourceTypesReq) (*ListResourceTypesResp, error)
+	RemoveResourceType(context.Context, *RemoveResourceTypeReq) (*RemoveResourceTypeResp, error)
+	PutResourcePrice(context.Context, *PutResourcePriceReq) (*PutResourcePriceResp, error)
+	ListResourcePrices(context.Context, *ListResourcePricesReq) (*ListResourcePricesResp, error)
+	RemoveResourcePrice(context.Context, *RemoveResourcePriceReq) (*RemoveResourcePriceResp, error)
+	GetCostReport(context.Context, *GetCostReportReq) (*GetCostReportResp, error)
+	GetUtilizationReport(context.Context, *GetUtilizationReportReq) (*GetUtilizationReportResp, error)
+	Simulate(context.Context, *SimulateReq) (*SimulateResp, error)
 	mustEmbedUnimplementedMeridianServer()
 }
 
@@ -137,24 +478,117 @@ func (UnimplementedMeridianServer) AddNamespace(context.Context, *AddNamespaceRe
 func (UnimplementedMeridianServer) RemoveNamespace(context.Context, *RemoveNamespaceReq) (*RemoveNamespaceResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveNamespace not implemented")
 }
+func (UnimplementedMeridianServer) MoveNamespace(context.Context, *MoveNamespaceReq) (*MoveNamespaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveNamespace not implemented")
+}
+func (UnimplementedMeridianServer) UpdateNamespace(context.Context, *UpdateNamespaceReq) (*UpdateNamespaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespace not implemented")
+}
 func (UnimplementedMeridianServer) AddApp(context.Context, *AddAppReq) (*AddAppResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddApp not implemented")
 }
 func (UnimplementedMeridianServer) RemoveApp(context.Context, *RemoveAppReq) (*RemoveAppResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveApp not implemented")
 }
+func (UnimplementedMeridianServer) ReserveQuota(context.Context, *ReserveQuotaReq) (*ReserveQuotaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveQuota not implemented")
+}
+func (UnimplementedMeridianServer) ListReservations(context.Context, *ListReservationsReq) (*ListReservationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReservations not implemented")
+}
+func (UnimplementedMeridianServer) CancelReservation(context.Context, *CancelReservationReq) (*CancelReservationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+}
+func (UnimplementedMeridianServer) GetApp(context.Context, *GetAppReq) (*GetAppResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApp not implemented")
+}
+func (UnimplementedMeridianServer) ListApps(context.Context, *ListAppsReq) (*ListAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApps not implemented")
+}
 func (UnimplementedMeridianServer) GetNamespace(context.Context, *GetNamespaceReq) (*GetNamespaceResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNamespace not implemented")
 }
+func (UnimplementedMeridianServer) ListNamespaces(context.Context, *ListNamespacesReq) (*ListNamespacesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaces not implemented")
+}
 func (UnimplementedMeridianServer) GetNamespaceHierarchy(context.Context, *GetNamespaceHierarchyReq) (*GetNamespaceHierarchyResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceHierarchy not implemented")
 }
 func (UnimplementedMeridianServer) SetNamespaceResources(context.Context, *SetNamespaceResourcesReq) (*SetNamespaceResourcesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceResources not implemented")
 }
+func (UnimplementedMeridianServer) CreateQuotaRequest(context.Context, *CreateQuotaRequestReq) (*CreateQuotaRequestResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuotaRequest not implemented")
+}
+func (UnimplementedMeridianServer) ListQuotaRequests(context.Context, *ListQuotaRequestsReq) (*ListQuotaRequestsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuotaRequests not implemented")
+}
+func (UnimplementedMeridianServer) ApproveQuotaRequest(context.Context, *ApproveQuotaRequestReq) (*ApproveQuotaRequestResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveQuotaRequest not implemented")
+}
+func (UnimplementedMeridianServer) RejectQuotaRequest(context.Context, *RejectQuotaRequestReq) (*RejectQuotaRequestResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectQuotaRequest not implemented")
+}
 func (UnimplementedMeridianServer) SetAppResources(context.Context, *SetAppResourcesReq) (*SetAppResourcesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAppResources not implemented")
 }
+func (UnimplementedMeridianServer) TransferQuota(context.Context, *TransferQuotaReq) (*TransferQuotaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferQuota not implemented")
+}
+func (UnimplementedMeridianServer) GetQuotaHistory(context.Context, *GetQuotaHistoryReq) (*GetQuotaHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotaHistory not implemented")
+}
+func (UnimplementedMeridianServer) ScheduleQuotaChange(context.Context, *ScheduleQuotaChangeReq) (*ScheduleQuotaChangeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleQuotaChange not implemented")
+}
+func (UnimplementedMeridianServer) ListScheduledQuotaChanges(context.Context, *ListScheduledQuotaChangesReq) (*ListScheduledQuotaChangesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledQuotaChanges not implemented")
+}
+func (UnimplementedMeridianServer) CancelScheduledQuotaChange(context.Context, *CancelScheduledQuotaChangeReq) (*CancelScheduledQuotaChangeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledQuotaChange not implemented")
+}
+func (UnimplementedMeridianServer) SetNamespaceOvercommit(context.Context, *SetNamespaceOvercommitReq) (*SetNamespaceOvercommitResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceOvercommit not implemented")
+}
+func (UnimplementedMeridianServer) SetNamespaceElasticQuotas(context.Context, *SetNamespaceElasticQuotasReq) (*SetNamespaceElasticQuotasResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceElasticQuotas not implemented")
+}
+func (UnimplementedMeridianServer) SetNamespaceUtilizationThresholds(context.Context, *SetNamespaceUtilizationThresholdsReq) (*SetNamespaceUtilizationThresholdsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceUtilizationThresholds not implemented")
+}
+func (UnimplementedMeridianServer) SetNamespaceLimitRange(context.Context, *SetNamespaceLimitRangeReq) (*SetNamespaceLimitRangeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceLimitRange not implemented")
+}
+func (UnimplementedMeridianServer) SetNamespaceCountQuotas(context.Context, *SetNamespaceCountQuotasReq) (*SetNamespaceCountQuotasResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceCountQuotas not implemented")
+}
+func (UnimplementedMeridianServer) PutResourceType(context.Context, *PutResourceTypeReq) (*PutResourceTypeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutResourceType not implemented")
+}
+func (UnimplementedMeridianServer) ListResourceTypes(context.Context, *ListResourceTypesReq) (*ListResourceTypesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceTypes not implemented")
+}
+func (UnimplementedMeridianServer) RemoveResourceType(context.Context, *RemoveResourceTypeReq) (*RemoveResourceTypeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveResourceType not implemented")
+}
+func (UnimplementedMeridianServer) PutResourcePrice(context.Context, *PutResourcePriceReq) (*PutResourcePriceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutResourcePrice not implemented")
+}
+func (UnimplementedMeridianServer) ListResourcePrices(context.Context, *ListResourcePricesReq) (*ListResourcePricesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourcePrices not implemented")
+}
+func (UnimplementedMeridianServer) RemoveResourcePrice(context.Context, *RemoveResourcePriceReq) (*RemoveResourcePriceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveResourcePrice not implemented")
+}
+func (UnimplementedMeridianServer) GetCostReport(context.Context, *GetCostReportReq) (*GetCostReportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCostReport not implemented")
+}
+func (UnimplementedMeridianServer) GetUtilizationReport(context.Context, *GetUtilizationReportReq) (*GetUtilizationReportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUtilizationReport not implemented")
+}
+func (UnimplementedMeridianServer) Simulate(context.Context, *SimulateReq) (*SimulateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
 func (UnimplementedMeridianServer) mustEmbedUnimplementedMeridianServer() {}
 
 // UnsafeMeridianServer may be embedded to opt out of forward compatibility for this service.
@@ -204,107 +638,323 @@ func _Meridian_RemoveNamespace_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Meridian_AddApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddAppReq)
+func _Meridian_MoveNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveNamespaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MeridianServer).AddApp(ctx, in)
+		return srv.(MeridianServer).MoveNamespace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Meridian/AddApp",
+		FullMethod: "/proto.Meridian/MoveNamespace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MeridianServer).AddApp(ctx, req.(*AddAppReq))
+		return srv.(MeridianServer).MoveNamespace(ctx, req.(*MoveNamespaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Meridian_RemoveApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveAppReq)
+func _Meridian_UpdateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNamespaceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MeridianServer).RemoveApp(ctx, in)
+		return srv.(MeridianServer).UpdateNamespace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Meridian/RemoveApp",
+		FullMethod: "/proto.Meridian/UpdateNamespace",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MeridianServer).RemoveApp(ctx, req.(*RemoveAppReq))
+		return srv.(MeridianServer).UpdateNamespace(ctx, req.(*UpdateNamespaceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Meridian_GetNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNamespaceReq)
+func _Meridian_AddApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAppReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MeridianServer).GetNamespace(ctx, in)
+		return srv.(MeridianServer).AddApp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Meridian/GetNamespace",
+		FullMethod: "/proto.Meridian/AddApp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MeridianServer).GetNamespace(ctx, req.(*GetNamespaceReq))
+		return srv.(MeridianServer).AddApp(ctx, req.(*AddAppReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Meridian_GetNamespaceHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNamespaceHierarchyReq)
+func _Meridian_RemoveApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAppReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MeridianServer).GetNamespaceHierarchy(ctx, in)
+		return srv.(MeridianServer).RemoveApp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Meridian/GetNamespaceHierarchy",
+		FullMethod: "/proto.Meridian/RemoveApp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MeridianServer).GetNamespaceHierarchy(ctx, req.(*GetNamespaceHierarchyReq))
+		return srv.(MeridianServer).RemoveApp(ctx, req.(*RemoveAppReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Meridian_SetNamespaceResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetNamespaceResourcesReq)
+func _Meridian_ReserveQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveQuotaReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MeridianServer).SetNamespaceResources(ctx, in)
+		return srv.(MeridianServer).ReserveQuota(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Meridian/SetNamespaceResources",
+		FullMethod: "/proto.Meridian/ReserveQuota",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MeridianServer).SetNamespaceResources(ctx, req.(*SetNamespaceResourcesReq))
+		return srv.(MeridianServer).ReserveQuota(ctx, req.(*ReserveQuotaReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Meridian_SetAppResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetAppResourcesReq)
+func _Meridian_ListReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReservationsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MeridianServer).SetAppResources(ctx, in)
+		return srv.(MeridianServer).ListReservations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Meridian/SetAppResources",
+		FullMethod: "/proto.Meridian/ListReservations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).ListReservations(ctx, req.(*ListReservationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReservationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).CancelReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/CancelReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).CancelReservation(ctx, req.(*CancelReservationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_GetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).GetApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/GetApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).GetApp(ctx, req.(*GetAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_ListApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).ListApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/ListApps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).ListApps(ctx, req.(*ListAppsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_GetNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).GetNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/GetNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).GetNamespace(ctx, req.(*GetNamespaceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).ListNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/ListNamespaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).ListNamespaces(ctx, req.(*ListNamespacesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_GetNamespaceHierarchy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceHierarchyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).GetNamespaceHierarchy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/GetNamespaceHierarchy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).GetNamespaceHierarchy(ctx, req.(*GetNamespaceHierarchyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_SetNamespaceResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceResourcesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).SetNamespaceResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/SetNamespaceResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).SetNamespaceResources(ctx, req.(*SetNamespaceResourcesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_CreateQuotaRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuotaRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).CreateQuotaRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/CreateQuotaRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).CreateQuotaRequest(ctx, req.(*CreateQuotaRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_ListQuotaRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuotaRequestsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).ListQuotaRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/ListQuotaRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).ListQuotaRequests(ctx, req.(*ListQuotaRequestsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_ApproveQuotaRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveQuotaRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).ApproveQuotaRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/ApproveQuotaRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).ApproveQuotaRequest(ctx, req.(*ApproveQuotaRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_RejectQuotaRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectQuotaRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).RejectQuotaRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/RejectQuotaRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).RejectQuotaRequest(ctx, req.(*RejectQuotaRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_SetAppResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAppResourcesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).SetAppResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/SetAppResources",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(MeridianServer).SetAppResources(ctx, req.(*SetAppResourcesReq))
@@ -312,6 +962,348 @@ func _Meridian_SetAppResources_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Meridian_TransferQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).TransferQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/TransferQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).TransferQuota(ctx, req.(*TransferQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_GetQuotaHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).GetQuotaHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/GetQuotaHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).GetQuotaHistory(ctx, req.(*GetQuotaHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_ScheduleQuotaChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleQuotaChangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).ScheduleQuotaChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/ScheduleQuotaChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).ScheduleQuotaChange(ctx, req.(*ScheduleQuotaChangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_ListScheduledQuotaChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledQuotaChangesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).ListScheduledQuotaChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/ListScheduledQuotaChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).ListScheduledQuotaChanges(ctx, req.(*ListScheduledQuotaChangesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_CancelScheduledQuotaChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledQuotaChangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).CancelScheduledQuotaChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/CancelScheduledQuotaChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).CancelScheduledQuotaChange(ctx, req.(*CancelScheduledQuotaChangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_SetNamespaceOvercommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceOvercommitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).SetNamespaceOvercommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/SetNamespaceOvercommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).SetNamespaceOvercommit(ctx, req.(*SetNamespaceOvercommitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_SetNamespaceElasticQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceElasticQuotasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).SetNamespaceElasticQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/SetNamespaceElasticQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).SetNamespaceElasticQuotas(ctx, req.(*SetNamespaceElasticQuotasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_SetNamespaceUtilizationThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceUtilizationThresholdsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).SetNamespaceUtilizationThresholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/SetNamespaceUtilizationThresholds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).SetNamespaceUtilizationThresholds(ctx, req.(*SetNamespaceUtilizationThresholdsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_SetNamespaceLimitRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceLimitRangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).SetNamespaceLimitRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/SetNamespaceLimitRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).SetNamespaceLimitRange(ctx, req.(*SetNamespaceLimitRangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_SetNamespaceCountQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceCountQuotasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).SetNamespaceCountQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/SetNamespaceCountQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).SetNamespaceCountQuotas(ctx, req.(*SetNamespaceCountQuotasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_PutResourceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutResourceTypeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).PutResourceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/PutResourceType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).PutResourceType(ctx, req.(*PutResourceTypeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_ListResourceTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourceTypesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).ListResourceTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/ListResourceTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).ListResourceTypes(ctx, req.(*ListResourceTypesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_RemoveResourceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveResourceTypeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).RemoveResourceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/RemoveResourceType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).RemoveResourceType(ctx, req.(*RemoveResourceTypeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_PutResourcePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutResourcePriceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).PutResourcePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/PutResourcePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).PutResourcePrice(ctx, req.(*PutResourcePriceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_ListResourcePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcePricesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).ListResourcePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/ListResourcePrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).ListResourcePrices(ctx, req.(*ListResourcePricesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_RemoveResourcePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveResourcePriceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).RemoveResourcePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/RemoveResourcePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).RemoveResourcePrice(ctx, req.(*RemoveResourcePriceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_GetCostReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCostReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).GetCostReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/GetCostReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).GetCostReport(ctx, req.(*GetCostReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_GetUtilizationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUtilizationReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).GetUtilizationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/GetUtilizationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).GetUtilizationReport(ctx, req.(*GetUtilizationReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meridian_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeridianServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Meridian/Simulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeridianServer).Simulate(ctx, req.(*SimulateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Meridian_ServiceDesc is the grpc.ServiceDesc for Meridian service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -327,6 +1319,14 @@ var Meridian_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveNamespace",
 			Handler:    _Meridian_RemoveNamespace_Handler,
 		},
+		{
+			MethodName: "MoveNamespace",
+			Handler:    _Meridian_MoveNamespace_Handler,
+		},
+		{
+			MethodName: "UpdateNamespace",
+			Handler:    _Meridian_UpdateNamespace_Handler,
+		},
 		{
 			MethodName: "AddApp",
 			Handler:    _Meridian_AddApp_Handler,
@@ -335,10 +1335,34 @@ var Meridian_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveApp",
 			Handler:    _Meridian_RemoveApp_Handler,
 		},
+		{
+			MethodName: "ReserveQuota",
+			Handler:    _Meridian_ReserveQuota_Handler,
+		},
+		{
+			MethodName: "ListReservations",
+			Handler:    _Meridian_ListReservations_Handler,
+		},
+		{
+			MethodName: "CancelReservation",
+			Handler:    _Meridian_CancelReservation_Handler,
+		},
+		{
+			MethodName: "GetApp",
+			Handler:    _Meridian_GetApp_Handler,
+		},
+		{
+			MethodName: "ListApps",
+			Handler:    _Meridian_ListApps_Handler,
+		},
 		{
 			MethodName: "GetNamespace",
 			Handler:    _Meridian_GetNamespace_Handler,
 		},
+		{
+			MethodName: "ListNamespaces",
+			Handler:    _Meridian_ListNamespaces_Handler,
+		},
 		{
 			MethodName: "GetNamespaceHierarchy",
 			Handler:    _Meridian_GetNamespaceHierarchy_Handler,
@@ -347,10 +1371,102 @@ var Meridian_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetNamespaceResources",
 			Handler:    _Meridian_SetNamespaceResources_Handler,
 		},
+		{
+			MethodName: "CreateQuotaRequest",
+			Handler:    _Meridian_CreateQuotaRequest_Handler,
+		},
+		{
+			MethodName: "ListQuotaRequests",
+			Handler:    _Meridian_ListQuotaRequests_Handler,
+		},
+		{
+			MethodName: "ApproveQuotaRequest",
+			Handler:    _Meridian_ApproveQuotaRequest_Handler,
+		},
+		{
+			MethodName: "RejectQuotaRequest",
+			Handler:    _Meridian_RejectQuotaRequest_Handler,
+		},
 		{
 			MethodName: "SetAppResources",
 			Handler:    _Meridian_SetAppResources_Handler,
 		},
+		{
+			MethodName: "TransferQuota",
+			Handler:    _Meridian_TransferQuota_Handler,
+		},
+		{
+			MethodName: "GetQuotaHistory",
+			Handler:    _Meridian_GetQuotaHistory_Handler,
+		},
+		{
+			MethodName: "ScheduleQuotaChange",
+			Handler:    _Meridian_ScheduleQuotaChange_Handler,
+		},
+		{
+			MethodName: "ListScheduledQuotaChanges",
+			Handler:    _Meridian_ListScheduledQuotaChanges_Handler,
+		},
+		{
+			MethodName: "CancelScheduledQuotaChange",
+			Handler:    _Meridian_CancelScheduledQuotaChange_Handler,
+		},
+		{
+			MethodName: "SetNamespaceOvercommit",
+			Handler:    _Meridian_SetNamespaceOvercommit_Handler,
+		},
+		{
+			MethodName: "SetNamespaceElasticQuotas",
+			Handler:    _Meridian_SetNamespaceElasticQuotas_Handler,
+		},
+		{
+			MethodName: "SetNamespaceUtilizationThresholds",
+			Handler:    _Meridian_SetNamespaceUtilizationThresholds_Handler,
+		},
+		{
+			MethodName: "SetNamespaceLimitRange",
+			Handler:    _Meridian_SetNamespaceLimitRange_Handler,
+		},
+		{
+			MethodName: "SetNamespaceCountQuotas",
+			Handler:    _Meridian_SetNamespaceCountQuotas_Handler,
+		},
+		{
+			MethodName: "PutResourceType",
+			Handler:    _Meridian_PutResourceType_Handler,
+		},
+		{
+			MethodName: "ListResourceTypes",
+			Handler:    _Meridian_ListResourceTypes_Handler,
+		},
+		{
+			MethodName: "RemoveResourceType",
+			Handler:    _Meridian_RemoveResourceType_Handler,
+		},
+		{
+			MethodName: "PutResourcePrice",
+			Handler:    _Meridian_PutResourcePrice_Handler,
+		},
+		{
+			MethodName: "ListResourcePrices",
+			Handler:    _Meridian_ListResourcePrices_Handler,
+		},
+		{
+			MethodName: "RemoveResourcePrice",
+			Handler:    _Meridian_RemoveResourcePrice_Handler,
+		},
+		{
+			MethodName: "GetCostReport",
+			Handler:    _Meridian_GetCostReport_Handler,
+		},
+		{
+			MethodName: "GetUtilizationReport",
+			Handler:    _Meridian_GetUtilizationReport_Handler,
+		},
+		{
+			MethodName: "Simulate",
+			Handler:    _Meridian_Simulate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "meridian.proto",